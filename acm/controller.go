@@ -0,0 +1,122 @@
+package acm
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+)
+
+// expiries holds the expiry of every certificate keyed by certificate ARN,
+// the gather functions read it as the expiry is not a CloudWatch metric
+var expiries = b.NewResourceCache()
+
+// certificate is a discovered certificate and its expiry
+type certificate struct {
+	rd       *b.ResourceDescription
+	notAfter *time.Time
+}
+
+func init() {
+	b.RegisterNamespace("AWS/CertificateManager", Metrics, CreateResourceList)
+	b.RegisterLabels("AWS/CertificateManager", "domain", "status", "certificate_type", "in_use")
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, cd *acm.CertificateDetail) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("CertificateArn"),
+			Value: cd.CertificateArn,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = cd.CertificateArn
+	rd.Name = cd.DomainName
+	rd.Type = aws.String("acm")
+	rd.Parent = nd
+	rd.Tags = tags
+	rd.Labels = map[string]string{
+		"domain":           aws.StringValue(cd.DomainName),
+		"status":           aws.StringValue(cd.Status),
+		"certificate_type": aws.StringValue(cd.Type),
+		"in_use":           strconv.FormatBool(len(cd.InUseBy) > 0),
+	}
+
+	return &rd, nil
+}
+
+// CreateResourceList fetches a list of all ACM certificates in the region
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating ACM resource list ...")
+	session := acm.New(nd.Parent.Session)
+	// Only RSA 1024 and 2048 bit certificates are listed unless other key types are included
+	input := acm.ListCertificatesInput{
+		Includes: &acm.Filters{
+			KeyTypes: aws.StringSlice(acm.KeyAlgorithm_Values()),
+		},
+	}
+	certificates := []*acm.CertificateSummary{}
+	err := session.ListCertificatesPages(&input, func(page *acm.ListCertificatesOutput, lastPage bool) bool {
+		certificates = append(certificates, page.CertificateSummaryList...)
+		return true
+	})
+	h.LogIfError(err)
+
+	var w sync.WaitGroup
+	w.Add(len(certificates))
+	ch := make(chan *certificate, len(certificates))
+	for _, c := range certificates {
+		go func(c *acm.CertificateSummary, wg *sync.WaitGroup) {
+			defer wg.Done()
+			tagsInput := acm.ListTagsForCertificateInput{
+				CertificateArn: c.CertificateArn,
+			}
+			tags, err := session.ListTagsForCertificate(&tagsInput)
+			h.LogIfError(err)
+
+			tl, found := nd.Parent.TagsFound(tags)
+			if !found {
+				return
+			}
+
+			input := acm.DescribeCertificateInput{
+				CertificateArn: c.CertificateArn,
+			}
+			output, err := session.DescribeCertificate(&input)
+			h.LogIfError(err)
+			if err != nil || output.Certificate == nil {
+				return
+			}
+
+			if r, err := createResourceDescription(nd, tl, output.Certificate); err == nil {
+				ch <- &certificate{rd: r, notAfter: output.Certificate.NotAfter}
+			}
+			h.LogIfError(err)
+		}(c, &w)
+	}
+	w.Wait()
+	close(ch)
+
+	resources := []*b.ResourceDescription{}
+	data := map[string]interface{}{}
+	for c := range ch {
+		resources = append(resources, c.rd)
+		data[*c.rd.ID] = c.notAfter
+	}
+	expiries.Replace(nd.Parent, data)
+
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
@@ -0,0 +1,71 @@
+package acm
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// gatherExpiry returns the value computed by expiry from the expiry of every
+// certificate, certificates which were not issued yet have no expiry
+func gatherExpiry(rds []*b.ResourceDescription, expiry func(notAfter time.Time, now time.Time) float64) []*b.NonCloudWatchMetric {
+	result := []*b.NonCloudWatchMetric{}
+	now := time.Now()
+	for _, rd := range rds {
+		v, ok := expiries.Get(rd)
+		if !ok {
+			continue
+		}
+		notAfter := v.(*time.Time)
+		if notAfter == nil {
+			continue
+		}
+		metric := b.NonCloudWatchMetric{
+			Timestamps: []*time.Time{aws.Time(now)},
+			Statistic:  "Average",
+			Resource:   rd,
+			Values:     []*float64{aws.Float64(expiry(*notAfter, now))},
+		}
+		result = append(result, &metric)
+	}
+
+	return result
+}
+
+func gatherExpiryTimestampFunc(rds []*b.ResourceDescription, start time.Time, end time.Time) ([]*b.NonCloudWatchMetric, error) {
+	return gatherExpiry(rds, func(notAfter time.Time, now time.Time) float64 {
+		return float64(notAfter.Unix())
+	}), nil
+}
+
+func gatherDaysToExpiryFunc(rds []*b.ResourceDescription, start time.Time, end time.Time) ([]*b.NonCloudWatchMetric, error) {
+	return gatherExpiry(rds, func(notAfter time.Time, now time.Time) float64 {
+		return notAfter.Sub(now).Hours() / 24
+	}), nil
+}
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"ExpiryTimestamp": {
+		Help:       aws.String("The time the certificate expires in seconds since the epoch"),
+		OutputName: aws.String("acm_certificate_expiry_timestamp_seconds"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.NON_CLOUDWATCH_KIND),
+		GatherFunc: gatherExpiryTimestampFunc,
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"DaysToExpiry": {
+		Help:       aws.String("The number of days until the certificate expires, negative once it has expired"),
+		OutputName: aws.String("acm_certificate_days_to_expiry"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.NON_CLOUDWATCH_KIND),
+		GatherFunc: gatherDaysToExpiryFunc,
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
@@ -0,0 +1,160 @@
+package alarms
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+const (
+	alarmType      = "cloudwatch-alarm"
+	alarmStateType = "cloudwatch-alarm-state"
+)
+
+// alarmState is the state of an alarm at the time of the last discovery
+type alarmState struct {
+	value   string
+	updated *time.Time
+}
+
+// states holds the last discovered state of every alarm keyed by alarm ARN,
+// the gather functions read the state from it
+var states = b.NewResourceCache()
+
+func init() {
+	b.RegisterNamespace("AWS/CloudWatch/Alarms", Metrics, CreateResourceList)
+	b.RegisterLabels("AWS/CloudWatch/Alarms", "alarm_name", "state", "namespace", "metric")
+}
+
+// alarm holds the fields of a metric or composite alarm used to build its resources
+type alarm struct {
+	arn       *string
+	name      *string
+	namespace *string
+	metric    *string
+	state     *string
+	updated   *time.Time
+}
+
+func newResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, a *alarm, rType string, state string) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	if err := rd.BuildDimensions([]*b.DimensionDescription{}); err != nil {
+		return nil, err
+	}
+
+	rd.ID = a.arn
+	rd.Name = a.name
+	rd.Type = aws.String(rType)
+	rd.Parent = nd
+	rd.Tags = tags
+	rd.Labels = map[string]string{
+		"alarm_name": aws.StringValue(a.name),
+		"state":      state,
+		"namespace":  aws.StringValue(a.namespace),
+		"metric":     aws.StringValue(a.metric),
+	}
+
+	return &rd, nil
+}
+
+// createResourceDescriptions creates a resource for the alarm itself and one
+// for every state the alarm can be in
+func createResourceDescriptions(nd *b.NamespaceDescription, tags []*b.TagDescription, a *alarm) ([]*b.ResourceDescription, error) {
+	resources := []*b.ResourceDescription{}
+	r, err := newResourceDescription(nd, tags, a, alarmType, "")
+	if err != nil {
+		return nil, err
+	}
+	resources = append(resources, r)
+
+	for _, state := range cloudwatch.StateValue_Values() {
+		r, err := newResourceDescription(nd, tags, a, alarmStateType, state)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// CreateResourceList fetches a list of all metric and composite alarms in the region
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating alarm resource list ...")
+	session := cloudwatch.New(nd.Parent.Session)
+	input := cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: aws.StringSlice(cloudwatch.AlarmType_Values()),
+	}
+	alarms := []*alarm{}
+	err := session.DescribeAlarmsPages(&input, func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		for _, ma := range page.MetricAlarms {
+			alarms = append(alarms, &alarm{
+				arn:       ma.AlarmArn,
+				name:      ma.AlarmName,
+				namespace: ma.Namespace,
+				metric:    ma.MetricName,
+				state:     ma.StateValue,
+				updated:   ma.StateUpdatedTimestamp,
+			})
+		}
+		for _, ca := range page.CompositeAlarms {
+			alarms = append(alarms, &alarm{
+				arn:     ca.AlarmArn,
+				name:    ca.AlarmName,
+				state:   ca.StateValue,
+				updated: ca.StateUpdatedTimestamp,
+			})
+		}
+		return true
+	})
+	h.LogIfError(err)
+
+	var w sync.WaitGroup
+	w.Add(len(alarms))
+	ch := make(chan []*b.ResourceDescription, len(alarms))
+	for _, a := range alarms {
+		go func(a *alarm, wg *sync.WaitGroup) {
+			defer wg.Done()
+			input := cloudwatch.ListTagsForResourceInput{
+				ResourceARN: a.arn,
+			}
+			tags, err := session.ListTagsForResource(&input)
+			h.LogIfError(err)
+
+			tl, found := nd.Parent.TagsFound(tags)
+
+			if found {
+				if r, err := createResourceDescriptions(nd, tl, a); err == nil {
+					ch <- r
+				}
+				h.LogIfError(err)
+			}
+		}(a, &w)
+	}
+	w.Wait()
+	close(ch)
+
+	data := map[string]interface{}{}
+	for _, a := range alarms {
+		data[*a.arn] = &alarmState{
+			value:   aws.StringValue(a.state),
+			updated: a.updated,
+		}
+	}
+	states.Replace(nd.Parent, data)
+
+	resources := []*b.ResourceDescription{}
+	for r := range ch {
+		resources = append(resources, r...)
+	}
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
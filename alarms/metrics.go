@@ -0,0 +1,84 @@
+package alarms
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+func findState(rd *b.ResourceDescription) (*alarmState, bool) {
+	if v, ok := states.Get(rd); ok {
+		return v.(*alarmState), true
+	}
+	return nil, false
+}
+
+func gatherStateFunc(rds []*b.ResourceDescription, start time.Time, end time.Time) ([]*b.NonCloudWatchMetric, error) {
+	result := []*b.NonCloudWatchMetric{}
+	for _, rd := range rds {
+		state, ok := findState(rd)
+		if !ok {
+			continue
+		}
+		value := 0.0
+		if rd.Labels["state"] == state.value {
+			value = 1.0
+		}
+		metric := b.NonCloudWatchMetric{
+			Timestamps: []*time.Time{aws.Time(time.Now())},
+			Statistic:  "Average",
+			Resource:   rd,
+			Values:     []*float64{aws.Float64(value)},
+		}
+		result = append(result, &metric)
+	}
+
+	return result, nil
+}
+
+func gatherStateUpdatedFunc(rds []*b.ResourceDescription, start time.Time, end time.Time) ([]*b.NonCloudWatchMetric, error) {
+	result := []*b.NonCloudWatchMetric{}
+	for _, rd := range rds {
+		state, ok := findState(rd)
+		if !ok || state.updated == nil {
+			continue
+		}
+		metric := b.NonCloudWatchMetric{
+			Timestamps: []*time.Time{aws.Time(time.Now())},
+			Statistic:  "Average",
+			Resource:   rd,
+			Values:     []*float64{aws.Float64(float64(state.updated.Unix()))},
+		}
+		result = append(result, &metric)
+	}
+
+	return result, nil
+}
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"AlarmState": {
+		Help:         aws.String("Whether the alarm is in the state of the state label, 1 if it is and 0 otherwise"),
+		OutputName:   aws.String("cloudwatch_alarm_state"),
+		Statistic:    h.StringPointers("Average"),
+		Kind:         aws.String(b.NON_CLOUDWATCH_KIND),
+		GatherFunc:   gatherStateFunc,
+		ResourceType: aws.String(alarmStateType),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"AlarmStateUpdated": {
+		Help:         aws.String("The time the alarm last changed its state in seconds since the epoch"),
+		OutputName:   aws.String("cloudwatch_alarm_state_updated_timestamp_seconds"),
+		Statistic:    h.StringPointers("Average"),
+		Kind:         aws.String(b.NON_CLOUDWATCH_KIND),
+		GatherFunc:   gatherStateUpdatedFunc,
+		ResourceType: aws.String(alarmType),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
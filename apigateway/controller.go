@@ -0,0 +1,240 @@
+package apigateway
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+)
+
+const (
+	restType      = "apigateway-rest"
+	httpType      = "apigateway-http"
+	websocketType = "apigateway-websocket"
+)
+
+func init() {
+	b.RegisterNamespace("AWS/ApiGateway", Metrics, CreateResourceList)
+	b.RegisterLabels("AWS/ApiGateway", "api", "stage")
+}
+
+// stageTags merges the tags of an API with the tags of one of its stages, the
+// stage taking precedence
+func stageTags(apiTags map[string]*string, stageTags map[string]*string) *apigateway.GetTagsOutput {
+	tags := map[string]*string{}
+	for k, v := range apiTags {
+		tags[k] = v
+	}
+	for k, v := range stageTags {
+		tags[k] = v
+	}
+	return &apigateway.GetTagsOutput{Tags: tags}
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, api *apigateway.RestApi, stage *apigateway.Stage) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("ApiName"),
+			Value: api.Name,
+		},
+		{
+			Name:  aws.String("Stage"),
+			Value: stage.StageName,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = aws.String(strings.Join([]string{*api.Id, *stage.StageName}, "/"))
+	rd.Name = api.Name
+	rd.Type = aws.String(restType)
+	rd.Parent = nd
+	rd.Tags = tags
+	rd.Labels = map[string]string{
+		"api":   *api.Name,
+		"stage": *stage.StageName,
+	}
+
+	return &rd, nil
+}
+
+func createV2ResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, api *apigatewayv2.Api, stage *apigatewayv2.Stage) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("ApiId"),
+			Value: api.ApiId,
+		},
+		{
+			Name:  aws.String("Stage"),
+			Value: stage.StageName,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = aws.String(strings.Join([]string{*api.ApiId, *stage.StageName}, "/"))
+	rd.Name = api.Name
+	if aws.StringValue(api.ProtocolType) == apigatewayv2.ProtocolTypeWebsocket {
+		rd.Type = aws.String(websocketType)
+	} else {
+		rd.Type = aws.String(httpType)
+	}
+	rd.Parent = nd
+	rd.Tags = tags
+	rd.Labels = map[string]string{
+		"api":   *api.Name,
+		"stage": *stage.StageName,
+	}
+
+	return &rd, nil
+}
+
+// listRestResources fetches a resource per stage of every REST API
+func listRestResources(nd *b.NamespaceDescription) []*b.ResourceDescription {
+	session := apigateway.New(nd.Parent.Session)
+	input := apigateway.GetRestApisInput{}
+	apis := []*apigateway.RestApi{}
+	err := session.GetRestApisPages(&input, func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
+		apis = append(apis, page.Items...)
+		return true
+	})
+	h.LogIfError(err)
+
+	var w sync.WaitGroup
+	w.Add(len(apis))
+	ch := make(chan []*b.ResourceDescription, len(apis))
+	for _, api := range apis {
+		go func(api *apigateway.RestApi, wg *sync.WaitGroup) {
+			defer wg.Done()
+			input := apigateway.GetStagesInput{
+				RestApiId: api.Id,
+			}
+			stages, err := session.GetStages(&input)
+			if err != nil {
+				h.LogIfError(err)
+				return
+			}
+
+			resources := []*b.ResourceDescription{}
+			for _, stage := range stages.Item {
+				tl, found := nd.Parent.TagsFound(stageTags(api.Tags, stage.Tags))
+				if !found {
+					continue
+				}
+				r, err := createResourceDescription(nd, tl, api, stage)
+				if err == nil {
+					resources = append(resources, r)
+				}
+				h.LogIfError(err)
+			}
+			ch <- resources
+		}(api, &w)
+	}
+	w.Wait()
+	close(ch)
+
+	resources := []*b.ResourceDescription{}
+	for r := range ch {
+		resources = append(resources, r...)
+	}
+	return resources
+}
+
+// listV2Apis fetches every HTTP and WebSocket API, following NextToken as there is no paginator for GetApis
+func listV2Apis(session *apigatewayv2.ApiGatewayV2) ([]*apigatewayv2.Api, error) {
+	apis := []*apigatewayv2.Api{}
+	input := apigatewayv2.GetApisInput{}
+	for {
+		page, err := session.GetApis(&input)
+		if err != nil {
+			return apis, err
+		}
+		apis = append(apis, page.Items...)
+		if page.NextToken == nil {
+			return apis, nil
+		}
+		input.NextToken = page.NextToken
+	}
+}
+
+// listV2Stages fetches every stage of an HTTP or WebSocket API, following NextToken as there is no paginator for GetStages
+func listV2Stages(session *apigatewayv2.ApiGatewayV2, apiID *string) ([]*apigatewayv2.Stage, error) {
+	stages := []*apigatewayv2.Stage{}
+	input := apigatewayv2.GetStagesInput{
+		ApiId: apiID,
+	}
+	for {
+		page, err := session.GetStages(&input)
+		if err != nil {
+			return stages, err
+		}
+		stages = append(stages, page.Items...)
+		if page.NextToken == nil {
+			return stages, nil
+		}
+		input.NextToken = page.NextToken
+	}
+}
+
+// listV2Resources fetches a resource per stage of every HTTP and WebSocket API
+func listV2Resources(nd *b.NamespaceDescription) []*b.ResourceDescription {
+	session := apigatewayv2.New(nd.Parent.Session)
+	apis, err := listV2Apis(session)
+	h.LogIfError(err)
+
+	var w sync.WaitGroup
+	w.Add(len(apis))
+	ch := make(chan []*b.ResourceDescription, len(apis))
+	for _, api := range apis {
+		go func(api *apigatewayv2.Api, wg *sync.WaitGroup) {
+			defer wg.Done()
+			stages, err := listV2Stages(session, api.ApiId)
+			h.LogIfError(err)
+
+			resources := []*b.ResourceDescription{}
+			for _, stage := range stages {
+				tl, found := nd.Parent.TagsFound(stageTags(api.Tags, stage.Tags))
+				if !found {
+					continue
+				}
+				r, err := createV2ResourceDescription(nd, tl, api, stage)
+				if err == nil {
+					resources = append(resources, r)
+				}
+				h.LogIfError(err)
+			}
+			ch <- resources
+		}(api, &w)
+	}
+	w.Wait()
+	close(ch)
+
+	resources := []*b.ResourceDescription{}
+	for r := range ch {
+		resources = append(resources, r...)
+	}
+	return resources
+}
+
+// CreateResourceList fetches a list of the stages of all REST, HTTP and WebSocket APIs in the region
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating API Gateway resource list ...")
+
+	resources := listRestResources(nd)
+	resources = append(resources, listV2Resources(nd)...)
+
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
@@ -0,0 +1,114 @@
+package apigateway
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// Metrics is a map of default MetricDescriptions for this namespace
+//
+// REST APIs report client and server errors as 4XXError and 5XXError while
+// HTTP APIs report them as 4xx and 5xx. WebSocket APIs report connections and
+// messages instead of requests.
+var Metrics = map[string]*b.MetricDescription{
+	"4XXError": {
+		Help:         aws.String("The number of client-side errors captured in a given period"),
+		OutputName:   aws.String("apigateway_4xx_error"),
+		Statistic:    h.StringPointers("Sum"),
+		Kind:         aws.String(b.CLOUDWATCH_KIND),
+		ResourceType: aws.String(restType),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"5XXError": {
+		Help:         aws.String("The number of server-side errors captured in a given period"),
+		OutputName:   aws.String("apigateway_5xx_error"),
+		Statistic:    h.StringPointers("Sum"),
+		Kind:         aws.String(b.CLOUDWATCH_KIND),
+		ResourceType: aws.String(restType),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"4xx": {
+		Help:         aws.String("The number of client-side errors captured in a given period"),
+		OutputName:   aws.String("apigateway_http_4xx_error"),
+		Statistic:    h.StringPointers("Sum"),
+		Kind:         aws.String(b.CLOUDWATCH_KIND),
+		ResourceType: aws.String(httpType),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"5xx": {
+		Help:         aws.String("The number of server-side errors captured in a given period"),
+		OutputName:   aws.String("apigateway_http_5xx_error"),
+		Statistic:    h.StringPointers("Sum"),
+		Kind:         aws.String(b.CLOUDWATCH_KIND),
+		ResourceType: aws.String(httpType),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"Count": {
+		Help:          aws.String("The total number of API requests in a given period"),
+		OutputName:    aws.String("apigateway_count"),
+		Statistic:     h.StringPointers("Sum"),
+		Kind:          aws.String(b.CLOUDWATCH_KIND),
+		ResourceTypes: h.StringPointers(restType, httpType),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"IntegrationLatency": {
+		Help:       aws.String("The time in milliseconds between when API Gateway relays a request to the backend and when it receives a response from the backend"),
+		OutputName: aws.String("apigateway_integration_latency"),
+		Statistic:  h.StringPointers("Average", "Maximum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"Latency": {
+		Help:          aws.String("The time in milliseconds between when API Gateway receives a request from a client and when it returns a response to the client"),
+		OutputName:    aws.String("apigateway_latency"),
+		Statistic:     h.StringPointers("Average", "Maximum"),
+		Kind:          aws.String(b.CLOUDWATCH_KIND),
+		ResourceTypes: h.StringPointers(restType, httpType),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"ClientError": {
+		Help:         aws.String("The number of requests to a WebSocket API that have a 4XX response returned by API Gateway before the integration is invoked"),
+		OutputName:   aws.String("apigateway_websocket_client_error"),
+		Statistic:    h.StringPointers("Sum"),
+		Kind:         aws.String(b.CLOUDWATCH_KIND),
+		ResourceType: aws.String(websocketType),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"ConnectCount": {
+		Help:         aws.String("The number of messages sent to the $connect route integration of a WebSocket API"),
+		OutputName:   aws.String("apigateway_websocket_connect_count"),
+		Statistic:    h.StringPointers("Sum"),
+		Kind:         aws.String(b.CLOUDWATCH_KIND),
+		ResourceType: aws.String(websocketType),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"ExecutionError": {
+		Help:         aws.String("The number of errors of a WebSocket API that occurred when calling the integration"),
+		OutputName:   aws.String("apigateway_websocket_execution_error"),
+		Statistic:    h.StringPointers("Sum"),
+		Kind:         aws.String(b.CLOUDWATCH_KIND),
+		ResourceType: aws.String(websocketType),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"MessageCount": {
+		Help:         aws.String("The number of messages sent to a WebSocket API, either from or to the client"),
+		OutputName:   aws.String("apigateway_websocket_message_count"),
+		Statistic:    h.StringPointers("Sum"),
+		Kind:         aws.String(b.CLOUDWATCH_KIND),
+		ResourceType: aws.String(websocketType),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
@@ -11,7 +11,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/backup"
 )
 
-func createResourceDescription(nd *b.NamespaceDescription, tags *string, vn *string) (*b.ResourceDescription, error) {
+func init() {
+	b.RegisterNamespace("AWS/Backup", Metrics, CreateResourceList)
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, vn *string) (*b.ResourceDescription, error) {
 	rd := b.ResourceDescription{}
 	dd := []*b.DimensionDescription{
 		{
@@ -36,14 +40,18 @@ func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 	log.Debug("Creating Backup resource list ...")
 	session := backup.New(nd.Parent.Session)
 	input := backup.ListBackupVaultsInput{}
-	result, err := session.ListBackupVaults(&input)
+	vaults := []*backup.VaultListMember{}
+	err := session.ListBackupVaultsPages(&input, func(page *backup.ListBackupVaultsOutput, lastPage bool) bool {
+		vaults = append(vaults, page.BackupVaultList...)
+		return true
+	})
 	h.LogIfError(err)
 
 	var w sync.WaitGroup
-	w.Add(len(result.BackupVaultList))
+	w.Add(len(vaults))
 
-	ch := make(chan *b.ResourceDescription, len(result.BackupVaultList))
-	for _, vault := range result.BackupVaultList {
+	ch := make(chan *b.ResourceDescription, len(vaults))
+	for _, vault := range vaults {
 		go func(vlm *backup.VaultListMember, wg *sync.WaitGroup) {
 			defer wg.Done()
 			input := backup.ListTagsInput{
@@ -53,10 +61,9 @@ func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 			h.LogIfError(err)
 
 			tl, found := nd.Parent.TagsFound(tags)
-			ts := b.TagsToString(tl)
 
 			if found {
-				if r, err := createResourceDescription(nd, ts, vlm.BackupVaultName); err == nil {
+				if r, err := createResourceDescription(nd, tl, vlm.BackupVaultName); err == nil {
 					ch <- r
 				}
 				h.LogIfError(err)
@@ -0,0 +1,67 @@
+package base
+
+import (
+	"sync"
+)
+
+// ResourceCache holds data about the resources of every region which the
+// gather functions of a namespace need besides the resources themselves, e.g.
+// values returned by the API used to discover them. Every resource discovery
+// replaces the data of its region, so data of resources which no longer exist
+// is dropped.
+type ResourceCache struct {
+	data  map[string]map[string]interface{} // Data keyed by RegionDescription.Key and then by resource ID
+	mutex sync.RWMutex
+}
+
+var (
+	caches      []*ResourceCache
+	cachesMutex sync.Mutex
+)
+
+// NewResourceCache creates a ResourceCache whose data is dropped along with the
+// series of a region when the region is removed. It is intended to be called
+// once per namespace, e.g. to initialise a package level variable.
+func NewResourceCache() *ResourceCache {
+	c := &ResourceCache{data: map[string]map[string]interface{}{}}
+	cachesMutex.Lock()
+	defer cachesMutex.Unlock()
+	caches = append(caches, c)
+	return c
+}
+
+// Replace replaces the data of a region with data keyed by resource ID.
+// Data of regions which were removed is dropped.
+func (c *ResourceCache) Replace(rd *RegionDescription, data map[string]interface{}) {
+	exporter.mutex.RLock()
+	defer exporter.mutex.RUnlock()
+	if rd.removed {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.data[rd.Key()] = data
+}
+
+// Get returns the data of a resource
+func (c *ResourceCache) Get(rd *ResourceDescription) (interface{}, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	v, ok := c.data[rd.Parent.Parent.Key()][*rd.ID]
+	return v, ok
+}
+
+func (c *ResourceCache) remove(rd *RegionDescription) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.data, rd.Key())
+}
+
+// removeCachedData drops the data of a region from every ResourceCache
+func removeCachedData(rd *RegionDescription) {
+	cachesMutex.Lock()
+	defer cachesMutex.Unlock()
+	for _, c := range caches {
+		c.remove(rd)
+	}
+}
@@ -0,0 +1,32 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceCache(t *testing.T) {
+	c := NewResourceCache()
+	rds := testResources(2)
+	region := rds[0].Parent.Parent
+
+	c.Replace(region, map[string]interface{}{"i-0": 1, "i-1": 2})
+	v, ok := c.Get(rds[1])
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+
+	// a discovery replaces the data of resources which no longer exist
+	c.Replace(region, map[string]interface{}{"i-0": 3})
+	_, ok = c.Get(rds[1])
+	assert.False(t, ok)
+
+	RemoveRegion(region)
+	_, ok = c.Get(rds[0])
+	assert.False(t, ok)
+
+	// a discovery which was still running when the region was removed
+	c.Replace(region, map[string]interface{}{"i-0": 4})
+	_, ok = c.Get(rds[0])
+	assert.False(t, ok)
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"time"
 )
@@ -13,7 +14,9 @@ type configMetric struct {
 	Help          string `yaml:"help"`   // Custom help text for the generated metric
 	GatherFunc    func([]*ResourceDescription, time.Time, time.Time) ([]*NonCloudWatchMetric, error)
 	Kind          string
+	ResourceType  string                  `yaml:"resource_type"`  // The type of resource the metric applies to
 	Dimensions    []*cloudwatch.Dimension `yaml:"dimensions"`     // The resource dimensions to generate individual series for (via labels)
+	SplitBy       *SplitDimension         `yaml:"split_by"`       // A dimension whose values each generate their own series
 	Statistics    []*string               `yaml:"statistics"`     // List of AWS statistics to use.
 	OutputName    string                  `yaml:"output_name"`    // Allows override of the generate metric name
 	PeriodSeconds int64                   `yaml:"period_seconds"` // Granularity of results from cloudwatch API.
@@ -24,6 +27,27 @@ type metric struct {
 	Data map[string][]*configMetric `yaml:",omitempty,inline"` // Map from namespace to list of metrics to scrape.
 }
 
+// AccountConfig describes an AWS account whose resources are monitored using an assumed role
+type AccountConfig struct {
+	AccountID  string            `yaml:"account_id"`            // AWS Account ID
+	RoleARN    string            `yaml:"role_arn,omitempty"`    // Role to assume in order to access the account
+	ExternalID string            `yaml:"external_id,omitempty"` // External ID to pass when assuming the role
+	Regions    []*string         `yaml:"regions,omitempty"`     // Which AWS regions to query, defaults to the top level regions
+	Tags       []*TagDescription `yaml:"tags,omitempty"`        // Tags to filter resources by, defaults to the top level tags
+}
+
+// BillingConfig selects the breakdowns of the estimated charges exported for the AWS/Billing namespace
+type BillingConfig struct {
+	ByService       bool `yaml:"by_service,omitempty"`        // Export the charges of every AWS service
+	ByLinkedAccount bool `yaml:"by_linked_account,omitempty"` // Export the charges of every linked account of an organization
+}
+
+// QuotaConfig identifies a service quota to track in the AWS/Usage namespace
+type QuotaConfig struct {
+	ServiceCode string `yaml:"service_code"` // Service Quotas code of the service, e.g. ec2
+	QuotaCode   string `yaml:"quota_code"`   // Service Quotas code of the quota, e.g. L-0263D0A3
+}
+
 // Config represents the exporter configuration passed which is read at runtime from a YAML file.
 type Config struct {
 	Listen    string `yaml:"listen,omitempty"` // TCP Dial address for Prometheus HTTP API to listen on
@@ -31,10 +55,27 @@ type Config struct {
 	APISecret string `yaml:"api_secret"`       // AWS API Secret
 	AccountID string `yaml:"account_id"`       // AWS Account ID
 
-	Tags         []*TagDescription `yaml:"tags,omitempty"`          // Tags to filter resources by
-	Regions      []*string         `yaml:"regions"`                 // Which AWS regions to query resources and metrics for
-	LogLevel     uint8             `yaml:"log_level,omitempty"`     // Logging verbosity level
-	PollInterval int64             `yaml:"poll_interval,omitempty"` // How often to fetch new data from the Cloudwatch API.
+	Profile   string            `yaml:"profile,omitempty"`      // Shared config profile used to establish a session
+	Endpoints map[string]string `yaml:"endpoint_url,omitempty"` // Custom endpoint URLs keyed by AWS endpoint ID, e.g. monitoring or ec2
+
+	Accounts []*AccountConfig `yaml:"accounts,omitempty"` // Accounts to monitor, defaults to account_id with the top level regions and tags
+
+	// Generate series per availability zone for ALB/NLB load balancers and target groups
+	ELBv2AvailabilityZones bool `yaml:"elbv2_availability_zones,omitempty"`
+
+	Billing BillingConfig  `yaml:"billing,omitempty"` // Breakdowns of the estimated charges, the total is always exported
+	Quotas  []*QuotaConfig `yaml:"quotas,omitempty"`  // Service quotas to track in the AWS/Usage namespace
+
+	Tags          []*TagDescription `yaml:"tags,omitempty"`            // Tags to filter resources by
+	TagLabels     []string          `yaml:"tag_labels,omitempty"`      // Tags to export as individual labels
+	DropTagsLabel bool              `yaml:"drop_tags_label,omitempty"` // Omit the label containing all tags joined by commas
+	Regions       []*string         `yaml:"regions"`                   // Which AWS regions to query resources and metrics for
+	LogLevel      uint8             `yaml:"log_level,omitempty"`       // Logging verbosity level
+	PollInterval  int64             `yaml:"poll_interval,omitempty"`   // How often to fetch new data from the Cloudwatch API.
+
+	// The exporter is reported as not ready if no metric of a region was
+	// polled successfully within this many poll intervals
+	ReadinessMultiplier int64 `yaml:"readiness_multiplier,omitempty"`
 
 	// Default values for metrics, will only be used for a metric if that
 	// metric does not have an override configured
@@ -44,8 +85,40 @@ type Config struct {
 	Metrics metric `yaml:"metrics"` // Map of per metric configuration overrides
 }
 
-// ConstructMetrics generates a map of MetricDescriptions keyed by CloudWatch namespace using the defaults provided in Config.
-func (c *Config) ConstructMetrics(defaults map[string]map[string]*MetricDescription) map[string][]*MetricDescription {
+// GetAccounts returns the accounts to monitor with the top level regions and
+// tags applied to accounts which do not set their own.
+//
+// If no accounts are configured the top level account_id is monitored with the
+// credentials of the exporter.
+func (c *Config) GetAccounts() []*AccountConfig {
+	if len(c.Accounts) == 0 {
+		return []*AccountConfig{
+			{
+				AccountID: c.AccountID,
+				Regions:   c.Regions,
+				Tags:      c.Tags,
+			},
+		}
+	}
+
+	accounts := []*AccountConfig{}
+	for _, a := range c.Accounts {
+		account := *a
+		if len(account.Regions) == 0 {
+			account.Regions = c.Regions
+		}
+		if len(account.Tags) == 0 {
+			account.Tags = c.Tags
+		}
+		accounts = append(accounts, &account)
+	}
+	return accounts
+}
+
+// ConstructMetrics generates a map of MetricDescriptions keyed by CloudWatch namespace using the defaults provided in Config
+// and the default metrics of the registered namespaces.
+func (c *Config) ConstructMetrics() map[string][]*MetricDescription {
+	defaults := GetDefaultMetrics()
 	mds := make(map[string][]*MetricDescription)
 	for namespace, metrics := range c.Metrics.Data {
 		if len(metrics) == 0 {
@@ -57,9 +130,11 @@ func (c *Config) ConstructMetrics(defaults map[string]map[string]*MetricDescript
 						Help:          *defaultMetric.Help,
 						Kind:          *defaultMetric.Kind,
 						GatherFunc:    defaultMetric.GatherFunc,
+						ResourceType:  aws.StringValue(defaultMetric.ResourceType),
 						PeriodSeconds: defaultMetric.PeriodSeconds,
 						RangeSeconds:  defaultMetric.RangeSeconds,
 						Dimensions:    defaultMetric.Dimensions,
+						SplitBy:       defaultMetric.SplitBy,
 						Statistics:    defaultMetric.Statistic,
 					})
 				}
@@ -101,6 +176,27 @@ func (c *Config) ConstructMetrics(defaults map[string]map[string]*MetricDescript
 				metric.Statistics = helpers.StringPointers("Average")
 			}
 
+			splitBy := metric.SplitBy
+			if splitBy == nil {
+				if d, ok := defaults[namespace][metric.AWSMetric]; ok {
+					splitBy = d.SplitBy
+				}
+			}
+
+			var resourceType *string
+			var resourceTypes []*string
+			if metric.ResourceType != "" {
+				resourceType = aws.String(metric.ResourceType)
+			} else if d, ok := defaults[namespace][metric.AWSMetric]; ok {
+				resourceType = d.ResourceType
+				resourceTypes = d.ResourceTypes
+			}
+
+			latest := false
+			if d, ok := defaults[namespace][metric.AWSMetric]; ok {
+				latest = d.Latest
+			}
+
 			help := metric.Help
 			if help == "" {
 				if d, ok := defaults[namespace][metric.AWSMetric]; ok {
@@ -115,11 +211,15 @@ func (c *Config) ConstructMetrics(defaults map[string]map[string]*MetricDescript
 				Help:          &help,
 				Kind:          &kind,
 				GatherFunc:    gatherFunc,
+				ResourceType:  resourceType,
+				ResourceTypes: resourceTypes,
 				OutputName:    &name,
 				Dimensions:    metric.Dimensions,
+				SplitBy:       splitBy,
 				PeriodSeconds: period,
 				RangeSeconds:  rangeSeconds,
 				Statistic:     metric.Statistics,
+				Latest:        latest,
 
 				Namespace: namespace,
 				AWSMetric: metric.AWSMetric,
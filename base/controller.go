@@ -2,7 +2,7 @@ package base
 
 import (
 	"fmt"
-	"regexp"
+	"reflect"
 	"sort"
 	"strings"
 	"sync"
@@ -13,16 +13,30 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
-	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
@@ -33,16 +47,72 @@ const (
 	CLOUDWATCH_KIND     = "CLOUDWATCH"
 )
 
-var alphaRegex = regexp.MustCompile("[^a-zA-Z0-9]+")
+const (
+	// maxQueriesPerCall is the maximum number of queries the CloudWatch API accepts in a single GetMetricData call
+	maxQueriesPerCall = 500
+	// maxConcurrentCalls limits the number of GetMetricData calls made concurrently for a single metric
+	maxConcurrentCalls = 5
+)
+
+const (
+	// GlobalRegion is the region polling the global namespaces of an account
+	GlobalRegion = "global"
+	// globalAWSRegion is the AWS region publishing the metrics of global services
+	globalAWSRegion = "us-east-1"
+)
+
+// CreateAWSSession establishes a session for an account in a region.
+//
+// Static credentials are used if api_key and api_secret are configured, otherwise
+// the SDK default credential chain is used. This covers environment variables,
+// shared config profiles including SSO, web identity tokens and ECS or EC2 roles.
+//
+// The GlobalRegion is mapped to the AWS region publishing the metrics of global services.
+func CreateAWSSession(config *Config, account *AccountConfig, region *string) *session.Session {
+	awsRegion := *region
+	if awsRegion == GlobalRegion {
+		awsRegion = globalAWSRegion
+	}
+	opts := session.Options{
+		Config:            *aws.NewConfig().WithRegion(awsRegion),
+		Profile:           config.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	}
+	if config.APIKey != "" && config.APISecret != "" {
+		opts.Config.Credentials = credentials.NewStaticCredentials(config.APIKey, config.APISecret, "")
+	}
+	if len(config.Endpoints) > 0 {
+		opts.Config.EndpointResolver = endpointResolver(config.Endpoints)
+	}
+	s := session.Must(session.NewSessionWithOptions(opts))
+	s.Handlers.CompleteAttempt.PushBack(observeAPIAttempt)
+	s.Handlers.Complete.PushBack(observeAPICall)
 
-func CreateAWSSession(config *Config, region *string) *session.Session {
-	if config.APIKey == "" || config.APISecret == "" {
-		return session.Must(session.NewSessionWithOptions(session.Options{
-			Config: *aws.NewConfig().WithRegion(*region).WithCredentials(ec2rolecreds.NewCredentials(session.New())),
-		}))
-	} else {
-		return session.Must(session.NewSession(&aws.Config{Region: region}))
+	if account.RoleARN == "" {
+		return s
 	}
+
+	// The assumed role credentials are refreshed by the SDK before they expire
+	creds := stscreds.NewCredentials(s, account.RoleARN, func(p *stscreds.AssumeRoleProvider) {
+		if account.ExternalID != "" {
+			p.ExternalID = aws.String(account.ExternalID)
+		}
+	})
+	return s.Copy(aws.NewConfig().WithCredentials(creds))
+}
+
+// endpointResolver resolves the services listed in urls to the custom URL
+// configured for them and every other service to its default endpoint
+func endpointResolver(urls map[string]string) endpoints.Resolver {
+	return endpoints.ResolverFunc(func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		if url, ok := urls[service]; ok {
+			return endpoints.ResolvedEndpoint{
+				URL:           url,
+				SigningRegion: region,
+			}, nil
+		}
+		return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+	})
 }
 
 // TagDescription represents an AWS tag key value pair
@@ -57,6 +127,19 @@ type DimensionDescription struct {
 	Value *string `yaml:"value"`
 }
 
+// SplitDimension is a dimension which is not part of a resource whose values
+// each generate their own series, e.g. the Operation of a DynamoDB table.
+// The value is exported as a label named after the dimension.
+type SplitDimension struct {
+	Name   *string   `yaml:"name"`
+	Values []*string `yaml:"values"`
+}
+
+// label returns the name of the label holding the value of the dimension
+func (sd *SplitDimension) label() string {
+	return h.ToPromString(*sd.Name)
+}
+
 // MetricDescription describes a single Cloudwatch metric with one or more
 // statistics to be monitored for relevant resources
 type MetricDescription struct {
@@ -65,21 +148,34 @@ type MetricDescription struct {
 	Help          *string
 	OutputName    *string
 	Dimensions    []*cloudwatch.Dimension
+	SplitBy       *SplitDimension
 	PeriodSeconds int64
 	RangeSeconds  int64
 	Statistic     []*string
 
+	// Export the most recent datapoint of the range instead of the statistic
+	// over every datapoint, e.g. for running totals which reset
+	Latest bool
+
 	Kind       *string
 	GatherFunc func([]*ResourceDescription, time.Time, time.Time) ([]*NonCloudWatchMetric, error)
 
+	// The type of resource the metric applies to, every resource of the namespace if nil
+	ResourceType *string
+	// The types of resource a metric published for several of them applies
+	// to, only used if ResourceType is nil
+	ResourceTypes []*string
+
 	timestamps map[AwsLabels]*time.Time
 	mutex      sync.RWMutex
 }
 
+// NonCloudWatchMetric holds the values of a custom metric for a single resource and statistic
 type NonCloudWatchMetric struct {
 	Values     []*float64
-	Label      *string
 	Timestamps []*time.Time
+	Statistic  string
+	Resource   *ResourceDescription
 }
 
 // RegionDescription describes an AWS region which will be monitored via cloudwatch
@@ -92,6 +188,12 @@ type RegionDescription struct {
 	Filters    []*ec2.Filter
 	Namespaces map[string]*NamespaceDescription
 	Mutex      sync.RWMutex
+
+	stopped    bool
+	removed    bool      // Set by RemoveRegion, guarded by the mutex of the exporter
+	discovered time.Time // Completion of the last resource discovery
+	gathered   time.Time // Completion of the last gather
+	polled     time.Time // Last poll of a metric which completed without errors
 }
 
 // NamespaceDescription describes an AWS namespace to be monitored via cloudwatch
@@ -114,7 +216,88 @@ type ResourceDescription struct {
 	Parent     *NamespaceDescription
 	Mutex      sync.RWMutex
 	Query      []*cloudwatch.MetricDataQuery
-	Tags       *string
+	Tags       []*TagDescription
+	Labels     map[string]string // Values of the labels registered for the namespace with RegisterLabels
+}
+
+// inheritFrom returns the MetricDescription from old describing the same metric
+// as md if nothing about it changed. Otherwise md is returned with the
+// timestamps of Sum statistics already exported copied over from old.
+func (md *MetricDescription) inheritFrom(old []*MetricDescription) *MetricDescription {
+	for _, o := range old {
+		if o.Namespace != md.Namespace || o.AWSMetric != md.AWSMetric || *o.OutputName != *md.OutputName {
+			continue
+		}
+		if md.equal(o) {
+			return o
+		}
+		o.mutex.RLock()
+		md.timestamps = make(map[AwsLabels]*time.Time, len(o.timestamps))
+		for labels, t := range o.timestamps {
+			md.timestamps[labels] = t
+		}
+		o.mutex.RUnlock()
+		return md
+	}
+	return md
+}
+
+// equal reports whether md and o describe the same query and output
+func (md *MetricDescription) equal(o *MetricDescription) bool {
+	return *md.Help == *o.Help &&
+		*md.Kind == *o.Kind &&
+		md.PeriodSeconds == o.PeriodSeconds &&
+		md.RangeSeconds == o.RangeSeconds &&
+		md.Latest == o.Latest &&
+		reflect.DeepEqual(md.Statistic, o.Statistic) &&
+		reflect.DeepEqual(md.Dimensions, o.Dimensions) &&
+		reflect.DeepEqual(md.SplitBy, o.SplitBy) &&
+		aws.StringValue(md.ResourceType) == aws.StringValue(o.ResourceType) &&
+		reflect.DeepEqual(md.ResourceTypes, o.ResourceTypes)
+}
+
+// HasMetricsFor reports whether a metric configured for the namespace is
+// queried for resources of the type, so that discovery can skip resources
+// which no metric applies to
+func (nd *NamespaceDescription) HasMetricsFor(resourceType string) bool {
+	nd.Mutex.RLock()
+	defer nd.Mutex.RUnlock()
+	for _, md := range nd.Metrics {
+		if md.appliesTo(resourceType) {
+			return true
+		}
+	}
+	return false
+}
+
+// appliesTo reports whether the metric is queried for resources of the type
+func (md *MetricDescription) appliesTo(resourceType string) bool {
+	if md.ResourceType != nil {
+		return *md.ResourceType == resourceType
+	}
+	if len(md.ResourceTypes) == 0 {
+		return true
+	}
+	for _, t := range md.ResourceTypes {
+		if *t == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// resources returns the resources in rds the metric applies to
+func (md *MetricDescription) resources(rds []*ResourceDescription) []*ResourceDescription {
+	if md.ResourceType == nil && len(md.ResourceTypes) == 0 {
+		return rds
+	}
+	result := []*ResourceDescription{}
+	for _, rd := range rds {
+		if md.appliesTo(*rd.Type) {
+			result = append(result, rd)
+		}
+	}
+	return result
 }
 
 func (md *MetricDescription) metricName(stat string) *string {
@@ -184,6 +367,10 @@ func (rd *RegionDescription) CreateNamespaceDescriptions(metrics map[string][]*M
 	namespaces := GetNamespaces()
 	rd.Namespaces = make(map[string]*NamespaceDescription)
 	for _, namespace := range namespaces {
+		// Global namespaces are only polled by the GlobalRegion and regional ones everywhere else
+		if isGlobalNamespace(namespace) != rd.IsGlobal() {
+			continue
+		}
 		nd := NamespaceDescription{
 			Namespace: aws.String(namespace),
 			Parent:    rd,
@@ -197,36 +384,120 @@ func (rd *RegionDescription) CreateNamespaceDescriptions(metrics map[string][]*M
 	return nil
 }
 
+// UpdateMetrics replaces the MetricDescriptions of every namespace in the region,
+// e.g. after the configuration has been reloaded.
+//
+// Metrics whose description did not change are kept as is, so that the state
+// used to avoid double counting Sum statistics survives the update. Series of
+// metrics which are no longer configured or which changed are dropped, the
+// latter are exported again with their new labels and help by the next poll.
+func (rd *RegionDescription) UpdateMetrics(metrics map[string][]*MetricDescription) {
+	names := map[string]bool{}
+	for namespace, nd := range rd.Namespaces {
+		nd.Mutex.Lock()
+		old := nd.Metrics
+		nd.Metrics = []*MetricDescription{}
+		for _, md := range metrics[namespace] {
+			inherited := md.inheritFrom(old)
+			if inherited != md {
+				for _, stat := range inherited.Statistic {
+					names[*inherited.metricName(*stat)] = true
+				}
+			}
+			nd.Metrics = append(nd.Metrics, inherited)
+		}
+		nd.Mutex.Unlock()
+	}
+	exporter.retain(rd.Key(), names)
+}
+
+// IsGlobal reports whether the region polls the global namespaces of its account
+func (rd *RegionDescription) IsGlobal() bool {
+	return *rd.Region == GlobalRegion
+}
+
+// Key uniquely identifies the region across all monitored accounts
+func (rd *RegionDescription) Key() string {
+	return *rd.AccountID + "/" + *rd.Region
+}
+
+// Stop marks the region as stopped. Results of gathers which are still in
+// flight are discarded instead of being exported.
+func (rd *RegionDescription) Stop() {
+	rd.Mutex.Lock()
+	defer rd.Mutex.Unlock()
+	rd.stopped = true
+}
+
+func (rd *RegionDescription) isStopped() bool {
+	rd.Mutex.RLock()
+	defer rd.Mutex.RUnlock()
+	return rd.stopped
+}
+
 // GatherMetrics queries the Cloudwatch API for metrics related to the resources in this region
-func (rd *RegionDescription) GatherMetrics(cw *cloudwatch.CloudWatch) {
+func (rd *RegionDescription) GatherMetrics(cw cloudwatchiface.CloudWatchAPI) {
 	log.Infof("Gathering metrics for region %s...", *rd.Region)
 
+	var wg sync.WaitGroup
+	wg.Add(len(rd.Namespaces))
 	for _, namespace := range rd.Namespaces {
-		go namespace.GatherMetrics(cw)
+		go func(nd *NamespaceDescription) {
+			defer wg.Done()
+			nd.GatherMetrics(cw)
+		}(namespace)
 	}
+	wg.Wait()
+	rd.markGathered()
 }
 
 // GatherMetrics queries the Cloudwatch API for metrics related to this AWS namespace in the parent region
-func (nd *NamespaceDescription) GatherMetrics(cw *cloudwatch.CloudWatch) {
-	for _, md := range nd.Metrics {
+func (nd *NamespaceDescription) GatherMetrics(cw cloudwatchiface.CloudWatchAPI) {
+	nd.Mutex.RLock()
+	metrics := nd.Metrics
+	nd.Mutex.RUnlock()
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	wg.Add(len(metrics))
+	for _, md := range metrics {
 		if md.Kind != nil && *md.Kind == NON_CLOUDWATCH_KIND {
 			go func(md *MetricDescription) {
+				defer wg.Done()
 				nd.Mutex.RLock()
-				result, err := md.getNCWData(nd.Resources)
+				resources := md.resources(nd.Resources)
 				nd.Mutex.RUnlock()
-				h.LogIfError(err)
-				md.saveNCWData(result, *nd.Parent.Region)
+				result, err := md.getNCWData(resources)
+				// getNCWData logs the error, the partial result of a failed
+				// gather would replace the gauges and drop series
+				if err != nil || nd.Parent.isStopped() {
+					return
+				}
+				md.saveNCWData(result, nd.Parent)
+				nd.observePoll(md)
 			}(md)
 		} else {
 			go func(md *MetricDescription) {
+				defer wg.Done()
 				nd.Mutex.RLock()
-				result, err := md.getCWData(cw, nd.Resources)
+				resources := md.resources(nd.Resources)
 				nd.Mutex.RUnlock()
+				result, targets, err := md.getCWData(cw, resources)
 				h.LogIfError(err)
-				md.saveCWData(result, *nd.Parent.Region)
+				// Saving the partial result of a failed poll would replace
+				// the gauges and drop the series of the failed batches
+				if err != nil || nd.Parent.isStopped() {
+					return
+				}
+				md.saveCWData(result, targets, nd.Parent)
+				nd.observePoll(md)
 			}(md)
 		}
 	}
+	wg.Wait()
+	if len(metrics) > 0 && !nd.Parent.isStopped() {
+		nd.observeGather(start)
+	}
 }
 
 // BuildDimensions coverts a slice of DimensionDescription to a slice of cloudwatchDimension and associates it with the resource
@@ -244,72 +515,106 @@ func (rd *ResourceDescription) BuildDimensions(dd []*DimensionDescription) error
 	return nil
 }
 
-func (rd *ResourceDescription) queryID(stat string) *string {
-	// Cloudwatch calls need a snake-case-unique-id
-	id := strings.ToLower(*rd.ID + "-" + stat)
-	id = alphaRegex.ReplaceAllString(id, "_")
-	return aws.String(id)
+// queryTarget is the resource, statistic and value of the split dimension a
+// MetricDataQuery was built for
+type queryTarget struct {
+	resource  *ResourceDescription
+	statistic string
+	split     string
 }
 
-// BuildQuery constructs and saves the cloudwatch query for all the metrics associated with the resource
-func (md *MetricDescription) BuildQuery(rds []*ResourceDescription) ([]*cloudwatch.MetricDataQuery, error) {
+// queryID returns the ID of the nth query of a GetMetricData call.
+//
+// Cloudwatch requires IDs which are unique within the call and start with a
+// lower case letter. They carry no information about the resource, which is
+// looked up from the queryTarget stored for the ID instead.
+func queryID(n int) *string {
+	return aws.String(fmt.Sprintf("q%d", n))
+}
+
+// awsLabels returns the labels identifying the series of the resource for a statistic
+func (rd *ResourceDescription) awsLabels(stat string) *AwsLabels {
+	return rd.awsSplitLabels(stat, "")
+}
+
+// awsSplitLabels returns the labels identifying the series of the resource for
+// a statistic and a value of the split dimension of the metric
+func (rd *ResourceDescription) awsSplitLabels(stat string, split string) *AwsLabels {
+	return &AwsLabels{
+		Statistic: stat,
+		Name:      *rd.Name,
+		Id:        *rd.ID,
+		RType:     *rd.Type,
+		Region:    *rd.Parent.Parent.Region,
+		AccountID: *rd.Parent.Parent.AccountID,
+		Tags:      *TagsToString(rd.Tags),
+		Split:     split,
+		Labels:    labelsToString(rd.Labels),
+	}
+}
+
+// BuildQuery constructs the cloudwatch query for all the resources associated with the metric.
+//
+// The returned map holds the resource and statistic each query was built for keyed by query ID.
+func (md *MetricDescription) BuildQuery(rds []*ResourceDescription) ([]*cloudwatch.MetricDataQuery, map[string]*queryTarget, error) {
 	query := []*cloudwatch.MetricDataQuery{}
+	targets := map[string]*queryTarget{}
+	splits := []*string{nil}
+	if md.SplitBy != nil {
+		splits = md.SplitBy.Values
+	}
 	for _, rd := range rds {
-		dimensions := rd.Dimensions
-		dimensions = append(dimensions, md.Dimensions...)
-		for _, stat := range md.Statistic {
-			cm := &cloudwatch.MetricDataQuery{
-				Id: rd.queryID(*stat),
-				MetricStat: &cloudwatch.MetricStat{
-					Metric: &cloudwatch.Metric{
-						MetricName: &md.AWSMetric,
-						Namespace:  rd.Parent.Namespace,
-						Dimensions: dimensions,
+		for _, split := range splits {
+			dimensions := []*cloudwatch.Dimension{}
+			dimensions = append(dimensions, rd.Dimensions...)
+			dimensions = append(dimensions, md.Dimensions...)
+			if split != nil {
+				dimensions = append(dimensions, &cloudwatch.Dimension{Name: md.SplitBy.Name, Value: split})
+			}
+			for _, stat := range md.Statistic {
+				id := queryID(len(query))
+				targets[*id] = &queryTarget{resource: rd, statistic: *stat, split: aws.StringValue(split)}
+				cm := &cloudwatch.MetricDataQuery{
+					Id: id,
+					MetricStat: &cloudwatch.MetricStat{
+						Metric: &cloudwatch.Metric{
+							MetricName: &md.AWSMetric,
+							Namespace:  rd.Parent.Namespace,
+							Dimensions: dimensions,
+						},
+						Stat:   stat,
+						Period: aws.Int64(md.PeriodSeconds),
 					},
-					Stat:   stat,
-					Period: aws.Int64(md.PeriodSeconds),
-				},
-				// We hardcode the label so that we can rely on the ordering in
-				// saveData.
-				Label:      aws.String((&AwsLabels{*stat, *rd.Name, *rd.ID, *rd.Type, *rd.Parent.Parent.Region, *rd.Tags}).String()),
-				ReturnData: aws.Bool(true),
+					// The label is informational only, results are matched to
+					// their resource by query ID in saveCWData.
+					Label:      aws.String(rd.awsSplitLabels(*stat, aws.StringValue(split)).String()),
+					ReturnData: aws.Bool(true),
+				}
+				query = append(query, cm)
 			}
-			query = append(query, cm)
 		}
 	}
-	return query, nil
+	return query, targets, nil
 }
 
+// AwsLabels identifies a single exported series
 type AwsLabels struct {
 	Statistic string
 	Name      string
 	Id        string
 	RType     string
 	Region    string
+	AccountID string
 	Tags      string
+	Split     string
+	Labels    string
 }
 
 func (l *AwsLabels) String() string {
-	return fmt.Sprintf("%s %s %s %s %s %s", l.Statistic, l.Name, l.Id, l.RType, l.Region, l.Tags)
-}
-
-func awsLabelsFromString(s string) (*AwsLabels, error) {
-	stringLabels := strings.Split(s, " ")
-	if len(stringLabels) < 6 {
-		return nil, fmt.Errorf("expected at least six labels, got %s", s)
-	}
-	labels := AwsLabels{
-		Statistic: stringLabels[0],
-		Name:      stringLabels[1],
-		Id:        stringLabels[2],
-		RType:     stringLabels[3],
-		Region:    stringLabels[4],
-		Tags:      stringLabels[5],
-	}
-	return &labels, nil
+	return fmt.Sprintf("%s %s %s %s %s %s %s %s %s", l.Statistic, l.Name, l.Id, l.RType, l.Region, l.AccountID, l.Tags, l.Split, l.Labels)
 }
 
-func (md *MetricDescription) saveCWData(c *cloudwatch.GetMetricDataOutput, region string) {
+func (md *MetricDescription) saveCWData(c *cloudwatch.GetMetricDataOutput, targets map[string]*queryTarget, rd *RegionDescription) {
 	newData := map[string][]*promMetric{}
 	for _, stat := range md.Statistic {
 		// pre-allocate in case the last resource for a stat goes away
@@ -320,17 +625,24 @@ func (md *MetricDescription) saveCWData(c *cloudwatch.GetMetricDataOutput, regio
 			continue
 		}
 
-		labels, err := awsLabelsFromString(*data.Label)
-		if err != nil {
-			h.LogIfError(err)
+		target, ok := targets[aws.StringValue(data.Id)]
+		if !ok {
+			labelErrors.WithLabelValues(md.Namespace, md.AWSMetric).Inc()
+			h.LogIfError(fmt.Errorf("unknown query id %s in results of %s", aws.StringValue(data.Id), md.AWSMetric))
 			continue
 		}
+		labels := target.resource.awsSplitLabels(target.statistic, target.split)
 
 		values := md.filterCWValues(data, labels)
 		if len(values) <= 0 {
 			continue
 		}
+		if md.Latest {
+			// AWS returns the data in descending order
+			values = values[:1]
+		}
 
+		var err error
 		value := 0.0
 		switch labels.Statistic {
 		case "Average":
@@ -351,7 +663,7 @@ func (md *MetricDescription) saveCWData(c *cloudwatch.GetMetricDataOutput, regio
 			continue
 		}
 
-		newData[labels.Statistic] = append(newData[labels.Statistic], &promMetric{value, []string{labels.Name, labels.Id, labels.RType, labels.Region, labels.Tags}})
+		newData[labels.Statistic] = append(newData[labels.Statistic], &promMetric{value, rd.labelValues(md, labels, target.resource)})
 	}
 	for stat, data := range newData {
 		name := *md.metricName(stat)
@@ -359,25 +671,13 @@ func (md *MetricDescription) saveCWData(c *cloudwatch.GetMetricDataOutput, regio
 			Name: name,
 			Help: *md.Help,
 		}
-		labels := []string{"name", "id", "type", "region", "tags"}
-
-		exporter.mutex.Lock()
-		if _, ok := exporter.data[name+region]; !ok {
-			if stat == "Sum" {
-				exporter.data[name+region] = NewBatchCounterVec(opts, labels)
-			} else {
-				exporter.data[name+region] = NewBatchGaugeVec(opts, labels)
-			}
-		}
-		exporter.mutex.Unlock()
+		labels := rd.labelNames(md)
 
-		exporter.mutex.RLock()
-		exporter.data[name+region].BatchUpdate(data)
-		exporter.mutex.RUnlock()
+		exporter.batchUpdate(rd, stat, opts, labels, data)
 	}
 }
 
-func (md *MetricDescription) saveNCWData(metrics []*NonCloudWatchMetric, region string) {
+func (md *MetricDescription) saveNCWData(metrics []*NonCloudWatchMetric, rd *RegionDescription) {
 	newData := map[string][]*promMetric{}
 	for _, stat := range md.Statistic {
 		// pre-allocate in case the last resource for a stat goes away
@@ -389,17 +689,19 @@ func (md *MetricDescription) saveNCWData(metrics []*NonCloudWatchMetric, region
 			continue
 		}
 
-		labels, err := awsLabelsFromString(*data.Label)
-		if err != nil {
-			h.LogIfError(err)
+		if data.Resource == nil {
+			labelErrors.WithLabelValues(md.Namespace, md.AWSMetric).Inc()
+			h.LogIfError(fmt.Errorf("missing resource in results of %s", md.AWSMetric))
 			continue
 		}
+		labels := data.Resource.awsLabels(data.Statistic)
 
 		values := md.filterNCWValues(data, labels)
 		if len(values) <= 0 {
 			continue
 		}
 
+		var err error
 		value := 0.0
 		switch labels.Statistic {
 		case "Average":
@@ -420,7 +722,7 @@ func (md *MetricDescription) saveNCWData(metrics []*NonCloudWatchMetric, region
 			continue
 		}
 
-		newData[labels.Statistic] = append(newData[labels.Statistic], &promMetric{value, []string{labels.Name, labels.Id, labels.RType, labels.Region, labels.Tags}})
+		newData[labels.Statistic] = append(newData[labels.Statistic], &promMetric{value, rd.labelValues(md, labels, data.Resource)})
 	}
 
 	for stat, data := range newData {
@@ -429,21 +731,9 @@ func (md *MetricDescription) saveNCWData(metrics []*NonCloudWatchMetric, region
 			Name: name,
 			Help: *md.Help,
 		}
-		labels := []string{"name", "id", "type", "region", "tags"}
+		labels := rd.labelNames(md)
 
-		exporter.mutex.Lock()
-		if _, ok := exporter.data[name+region]; !ok {
-			if stat == "Sum" {
-				exporter.data[name+region] = NewBatchCounterVec(opts, labels)
-			} else {
-				exporter.data[name+region] = NewBatchGaugeVec(opts, labels)
-			}
-		}
-		exporter.mutex.Unlock()
-
-		exporter.mutex.RLock()
-		exporter.data[name+region].BatchUpdate(data)
-		exporter.mutex.RUnlock()
+		exporter.batchUpdate(rd, stat, opts, labels, data)
 	}
 }
 
@@ -552,6 +842,100 @@ func (rd *RegionDescription) TagsFound(tl interface{}) ([]*TagDescription, bool)
 			}
 			tags = append(tags, &t)
 		}
+	case *lambda.ListTagsOutput:
+		if len(i.Tags) < 1 {
+			return tags, false
+		}
+		for key, value := range i.Tags {
+			t := TagDescription{
+				Key:   aws.String(key),
+				Value: value,
+			}
+			tags = append(tags, &t)
+		}
+	case *dynamodb.ListTagsOfResourceOutput:
+		if len(i.Tags) < 1 {
+			return tags, false
+		}
+		for _, tag := range i.Tags {
+			t := TagDescription{}
+			awsutil.Copy(&t, tag)
+			tags = append(tags, &t)
+		}
+	case *ecs.ListTagsForResourceOutput:
+		if len(i.Tags) < 1 {
+			return tags, false
+		}
+		for _, tag := range i.Tags {
+			t := TagDescription{}
+			awsutil.Copy(&t, tag)
+			tags = append(tags, &t)
+		}
+	case *kinesis.ListTagsForStreamOutput:
+		if len(i.Tags) < 1 {
+			return tags, false
+		}
+		for _, tag := range i.Tags {
+			t := TagDescription{}
+			awsutil.Copy(&t, tag)
+			tags = append(tags, &t)
+		}
+	case *firehose.ListTagsForDeliveryStreamOutput:
+		if len(i.Tags) < 1 {
+			return tags, false
+		}
+		for _, tag := range i.Tags {
+			t := TagDescription{}
+			awsutil.Copy(&t, tag)
+			tags = append(tags, &t)
+		}
+	case *sns.ListTagsForResourceOutput:
+		if len(i.Tags) < 1 {
+			return tags, false
+		}
+		for _, tag := range i.Tags {
+			t := TagDescription{}
+			awsutil.Copy(&t, tag)
+			tags = append(tags, &t)
+		}
+	case *eventbridge.ListTagsForResourceOutput:
+		if len(i.Tags) < 1 {
+			return tags, false
+		}
+		for _, tag := range i.Tags {
+			t := TagDescription{}
+			awsutil.Copy(&t, tag)
+			tags = append(tags, &t)
+		}
+	case *apigateway.GetTagsOutput:
+		if len(i.Tags) < 1 {
+			return tags, false
+		}
+		for key, value := range i.Tags {
+			t := TagDescription{
+				Key:   aws.String(key),
+				Value: value,
+			}
+			tags = append(tags, &t)
+		}
+	case *cloudfront.ListTagsForResourceOutput:
+		if i.Tags == nil || len(i.Tags.Items) < 1 {
+			return tags, false
+		}
+		for _, tag := range i.Tags.Items {
+			t := TagDescription{}
+			awsutil.Copy(&t, tag)
+			tags = append(tags, &t)
+		}
+	case *route53.ResourceTagSet:
+		if len(i.Tags) < 1 {
+			return tags, false
+		}
+		for _, tag := range i.Tags {
+			t := TagDescription{}
+			awsutil.Copy(&t, tag)
+			tags = append(tags, &t)
+		}
 	case *backup.ListTagsOutput:
 		if len(i.Tags) < 1 {
 			return tags, false
@@ -563,6 +947,24 @@ func (rd *RegionDescription) TagsFound(tl interface{}) ([]*TagDescription, bool)
 			}
 			tags = append(tags, &t)
 		}
+	case *acm.ListTagsForCertificateOutput:
+		if len(i.Tags) < 1 {
+			return tags, false
+		}
+		for _, tag := range i.Tags {
+			t := TagDescription{}
+			awsutil.Copy(&t, tag)
+			tags = append(tags, &t)
+		}
+	case *cloudwatch.ListTagsForResourceOutput:
+		if len(i.Tags) < 1 {
+			return tags, false
+		}
+		for _, tag := range i.Tags {
+			t := TagDescription{}
+			awsutil.Copy(&t, tag)
+			tags = append(tags, &t)
+		}
 
 	default:
 		return tags, false
@@ -609,25 +1011,113 @@ func TagsToString(tags []*TagDescription) *string {
 }
 
 // This function is used to fetch data from cloudwatch
-func (md *MetricDescription) getCWData(cw *cloudwatch.CloudWatch, rds []*ResourceDescription) (*cloudwatch.GetMetricDataOutput, error) {
-	query, err := md.BuildQuery(rds)
+func (md *MetricDescription) getCWData(cw cloudwatchiface.CloudWatchAPI, rds []*ResourceDescription) (*cloudwatch.GetMetricDataOutput, map[string]*queryTarget, error) {
+	query, targets, err := md.BuildQuery(rds)
 	if len(query) == 0 {
-		return &cloudwatch.GetMetricDataOutput{}, nil
+		return &cloudwatch.GetMetricDataOutput{}, targets, nil
 	}
 	h.LogIfError(err)
 
 	end := time.Now().Round(5 * time.Minute)
 	start := end.Add(-time.Duration(md.RangeSeconds) * time.Second)
 
+	if md.PeriodSeconds > 0 {
+		datapointsRequested.WithLabelValues(md.Namespace).Add(float64(int64(len(query)) * (md.RangeSeconds / md.PeriodSeconds)))
+	}
+
+	results, gatherErr := GetMetricData(cw, query, start, end)
+	if err == nil {
+		err = gatherErr
+	}
+	return &cloudwatch.GetMetricDataOutput{MetricDataResults: results}, targets, err
+}
+
+// GetMetricData fetches the results of the queries from the CloudWatch API.
+//
+// The queries are split into batches which fit in a single GetMetricData call,
+// the batches are fetched concurrently and every page of each batch is merged
+// into one result. Results are returned even if some batches failed, the
+// error is the first error encountered.
+func GetMetricData(cw cloudwatchiface.CloudWatchAPI, query []*cloudwatch.MetricDataQuery, start time.Time, end time.Time) ([]*cloudwatch.MetricDataResult, error) {
+	batches := batchQueries(query, maxQueriesPerCall)
+	results := make([][]*cloudwatch.MetricDataResult, len(batches))
+	errs := make([]error, len(batches))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentCalls)
+	for i, batch := range batches {
+		wg.Add(1)
+		go func(i int, batch []*cloudwatch.MetricDataQuery) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i], errs[i] = getMetricDataPages(cw, batch, start, end)
+		}(i, batch)
+	}
+	wg.Wait()
+
+	var err error
+	result := []*cloudwatch.MetricDataResult{}
+	for i := range batches {
+		h.LogIfError(errs[i])
+		if err == nil {
+			err = errs[i]
+		}
+		result = append(result, results[i]...)
+	}
+
+	return result, err
+}
+
+// batchQueries splits the queries into batches of at most size queries
+func batchQueries(query []*cloudwatch.MetricDataQuery, size int) [][]*cloudwatch.MetricDataQuery {
+	batches := [][]*cloudwatch.MetricDataQuery{}
+	for i := 0; i < len(query); i += size {
+		end := i + size
+		if end > len(query) {
+			end = len(query)
+		}
+		batches = append(batches, query[i:end])
+	}
+	return batches
+}
+
+// getMetricDataPages fetches every page of a single GetMetricData call.
+//
+// A query can be split across pages, so the values and timestamps of results
+// sharing the same query ID are merged.
+func getMetricDataPages(cw cloudwatchiface.CloudWatchAPI, query []*cloudwatch.MetricDataQuery, start time.Time, end time.Time) ([]*cloudwatch.MetricDataResult, error) {
 	input := cloudwatch.GetMetricDataInput{
 		StartTime:         &start,
 		EndTime:           &end,
 		MetricDataQueries: query,
 	}
-	result, err := cw.GetMetricData(&input)
-	h.LogIfError(err)
 
-	return result, err
+	results := []*cloudwatch.MetricDataResult{}
+	byID := map[string]*cloudwatch.MetricDataResult{}
+	for {
+		output, err := cw.GetMetricData(&input)
+		if err != nil {
+			return results, err
+		}
+		for _, data := range output.MetricDataResults {
+			if data.Id == nil {
+				results = append(results, data)
+				continue
+			}
+			if r, ok := byID[*data.Id]; ok {
+				r.Values = append(r.Values, data.Values...)
+				r.Timestamps = append(r.Timestamps, data.Timestamps...)
+				continue
+			}
+			byID[*data.Id] = data
+			results = append(results, data)
+		}
+		if output.NextToken == nil || *output.NextToken == "" {
+			return results, nil
+		}
+		input.NextToken = output.NextToken
+	}
 }
 
 // This function is used to fetch data from AWS resources(non-cloudwatch)
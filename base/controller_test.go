@@ -0,0 +1,439 @@
+package base
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeCloudWatch answers GetMetricData calls with one datapoint per query,
+// splitting every call into pages of pageSize results.
+type fakeCloudWatch struct {
+	cloudwatchiface.CloudWatchAPI
+
+	pageSize int
+	err      error
+	failID   string // Fail every call which includes the query with this ID
+
+	mutex     sync.Mutex
+	calls     int
+	batchSize []int
+}
+
+func (f *fakeCloudWatch) GetMetricData(input *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+	f.mutex.Lock()
+	f.calls++
+	if input.NextToken == nil {
+		f.batchSize = append(f.batchSize, len(input.MetricDataQueries))
+	}
+	f.mutex.Unlock()
+
+	if f.err != nil {
+		return &cloudwatch.GetMetricDataOutput{}, f.err
+	}
+	for _, q := range input.MetricDataQueries {
+		if *q.Id == f.failID {
+			return &cloudwatch.GetMetricDataOutput{}, errors.New("throttled")
+		}
+	}
+	if len(input.MetricDataQueries) > maxQueriesPerCall {
+		return &cloudwatch.GetMetricDataOutput{}, errors.New("too many queries")
+	}
+
+	page := 0
+	if input.NextToken != nil {
+		fmt.Sscanf(*input.NextToken, "%d", &page)
+	}
+
+	output := &cloudwatch.GetMetricDataOutput{}
+	for _, q := range input.MetricDataQueries {
+		output.MetricDataResults = append(output.MetricDataResults, &cloudwatch.MetricDataResult{
+			Id:         q.Id,
+			Label:      q.Label,
+			Values:     []*float64{aws.Float64(float64(page))},
+			Timestamps: []*time.Time{aws.Time(input.EndTime.Add(-time.Duration(page) * time.Minute))},
+		})
+	}
+	if page+1 < f.pageSize {
+		output.NextToken = aws.String(fmt.Sprintf("%d", page+1))
+	}
+	return output, nil
+}
+
+func testResources(n int) []*ResourceDescription {
+	region := &RegionDescription{Region: aws.String("ap-southeast-2"), AccountID: aws.String("123456789012")}
+	nd := &NamespaceDescription{Namespace: aws.String("AWS/EC2"), Parent: region}
+	rds := make([]*ResourceDescription, n)
+	for i := range rds {
+		id := fmt.Sprintf("i-%d", i)
+		rds[i] = &ResourceDescription{
+			Name:   aws.String(id),
+			ID:     aws.String(id),
+			Type:   aws.String("ec2"),
+			Parent: nd,
+		}
+	}
+	return rds
+}
+
+func testMetric(stats ...string) *MetricDescription {
+	return &MetricDescription{
+		AWSMetric:     "CPUUtilization",
+		Namespace:     "AWS/EC2",
+		OutputName:    aws.String("ec2_cpu_utilization"),
+		Help:          aws.String("help"),
+		PeriodSeconds: 60,
+		RangeSeconds:  300,
+		Statistic:     aws.StringSlice(stats),
+	}
+}
+
+func TestBatchQueries(t *testing.T) {
+	query := make([]*cloudwatch.MetricDataQuery, 1201)
+	batches := batchQueries(query, maxQueriesPerCall)
+	assert.Len(t, batches, 3)
+	assert.Len(t, batches[0], 500)
+	assert.Len(t, batches[1], 500)
+	assert.Len(t, batches[2], 201)
+
+	assert.Empty(t, batchQueries(nil, maxQueriesPerCall))
+}
+
+func TestGetCWDataBatches(t *testing.T) {
+	cw := &fakeCloudWatch{pageSize: 1}
+	md := testMetric("Average", "Maximum", "Sum")
+
+	result, _, err := md.getCWData(cw, testResources(400))
+	assert.NoError(t, err)
+	assert.Len(t, result.MetricDataResults, 1200)
+	assert.ElementsMatch(t, []int{500, 500, 200}, cw.batchSize)
+}
+
+func TestGetCWDataPages(t *testing.T) {
+	cw := &fakeCloudWatch{pageSize: 3}
+	md := testMetric("Average")
+
+	result, _, err := md.getCWData(cw, testResources(600))
+	assert.NoError(t, err)
+	assert.Equal(t, 6, cw.calls)
+	assert.Len(t, result.MetricDataResults, 600)
+	for _, data := range result.MetricDataResults {
+		assert.Equal(t, []*float64{aws.Float64(0), aws.Float64(1), aws.Float64(2)}, data.Values)
+		assert.Len(t, data.Timestamps, 3)
+	}
+}
+
+func TestGetCWDataError(t *testing.T) {
+	cw := &fakeCloudWatch{pageSize: 1, err: errors.New("throttled")}
+	md := testMetric("Average")
+
+	result, _, err := md.getCWData(cw, testResources(10))
+	assert.EqualError(t, err, "throttled")
+	assert.Empty(t, result.MetricDataResults)
+}
+
+func TestGatherMetricsKeepsSeriesOnFailedBatch(t *testing.T) {
+	rds := testResources(600)
+	md := testMetric("Average")
+	md.OutputName = aws.String("test_failed_batch")
+	nd := rds[0].Parent
+	nd.Metrics = []*MetricDescription{md}
+	nd.Resources = rds
+
+	nd.GatherMetrics(&fakeCloudWatch{pageSize: 1})
+	assert.Len(t, collectLabels(t, nd.Parent, "test_failed_batch"), 600)
+
+	// the second batch starts at the query after the first maxQueriesPerCall
+	nd.GatherMetrics(&fakeCloudWatch{pageSize: 1, failID: *queryID(maxQueriesPerCall)})
+	assert.Len(t, collectLabels(t, nd.Parent, "test_failed_batch"), 600)
+}
+
+func TestGatherMetricsKeepsSeriesOnFailedNCWGather(t *testing.T) {
+	rds := testResources(3)
+	md := testMetric("Average")
+	md.OutputName = aws.String("test_failed_ncw_gather")
+	md.Kind = aws.String(NON_CLOUDWATCH_KIND)
+	nd := rds[0].Parent
+	nd.Metrics = []*MetricDescription{md}
+	nd.Resources = rds
+
+	var gatherErr error
+	md.GatherFunc = func(rds []*ResourceDescription, start time.Time, end time.Time) ([]*NonCloudWatchMetric, error) {
+		result := []*NonCloudWatchMetric{}
+		for _, rd := range rds {
+			result = append(result, &NonCloudWatchMetric{
+				Values:     []*float64{aws.Float64(1)},
+				Timestamps: []*time.Time{aws.Time(time.Now())},
+				Statistic:  "Average",
+				Resource:   rd,
+			})
+			if gatherErr != nil {
+				break
+			}
+		}
+		return result, gatherErr
+	}
+
+	nd.GatherMetrics(&fakeCloudWatch{pageSize: 1})
+	assert.Len(t, collectLabels(t, nd.Parent, "test_failed_ncw_gather"), 3)
+
+	gatherErr = errors.New("throttled")
+	nd.GatherMetrics(&fakeCloudWatch{pageSize: 1})
+	assert.Len(t, collectLabels(t, nd.Parent, "test_failed_ncw_gather"), 3)
+}
+
+func TestGetCWDataEmpty(t *testing.T) {
+	cw := &fakeCloudWatch{pageSize: 1}
+	md := testMetric("Average")
+
+	result, _, err := md.getCWData(cw, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, result.MetricDataResults)
+	assert.Equal(t, 0, cw.calls)
+}
+
+// collectLabels returns the label values of every series exported for a metric of a region
+func collectLabels(t *testing.T, rd *RegionDescription, name string) []map[string]string {
+	exporter.mutex.RLock()
+	collector, ok := exporter.data[rd.Key()][name]
+	exporter.mutex.RUnlock()
+	if !ok {
+		t.Fatalf("metric %s was not exported", name)
+	}
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		collector.Collect(ch)
+		close(ch)
+	}()
+
+	series := []map[string]string{}
+	for m := range ch {
+		pb := &dto.Metric{}
+		assert.NoError(t, m.Write(pb))
+		labels := map[string]string{}
+		for _, l := range pb.Label {
+			labels[l.GetName()] = l.GetValue()
+		}
+		series = append(series, labels)
+	}
+	return series
+}
+
+var awkwardResources = []struct {
+	name string
+	id   string
+	tags []*TagDescription
+}{
+	{"web server 1", "i-1", []*TagDescription{{Key: aws.String("Team"), Value: aws.String("core platform")}}},
+	{"db,primary=true", "arn:aws:rds:eu-west-1:123456789012:db:db primary", []*TagDescription{{Key: aws.String("Team"), Value: aws.String("a,b=c d")}}},
+	{"  ", "queue\twith\ttabs", []*TagDescription{{Key: aws.String("Team"), Value: aws.String("ünïcödé 🚀")}}},
+	{"", "empty-name", nil},
+}
+
+func awkwardRegion() ([]*ResourceDescription, *RegionDescription) {
+	region := &RegionDescription{
+		Region:    aws.String("eu-west-1"),
+		AccountID: aws.String("123456789012"),
+		Config:    &Config{TagLabels: []string{"Team"}},
+	}
+	nd := &NamespaceDescription{Namespace: aws.String("AWS/RDS"), Parent: region}
+	rds := []*ResourceDescription{}
+	for _, r := range awkwardResources {
+		rds = append(rds, &ResourceDescription{
+			Name:   aws.String(r.name),
+			ID:     aws.String(r.id),
+			Type:   aws.String("awkward type"),
+			Parent: nd,
+			Tags:   r.tags,
+		})
+	}
+	return rds, region
+}
+
+func expectedAwkwardLabels() []map[string]string {
+	expected := []map[string]string{}
+	for _, r := range awkwardResources {
+		team := ""
+		if len(r.tags) > 0 {
+			team = *r.tags[0].Value
+		}
+		expected = append(expected, map[string]string{
+			"name":       r.name,
+			"id":         r.id,
+			"type":       "awkward type",
+			"region":     "eu-west-1",
+			"account_id": "123456789012",
+			"tags":       *TagsToString(r.tags),
+			"tag_team":   team,
+		})
+	}
+	return expected
+}
+
+func TestSaveCWDataRoundTrip(t *testing.T) {
+	rds, region := awkwardRegion()
+	md := testMetric("Average")
+	md.OutputName = aws.String("test_round_trip_cw")
+
+	result, targets, err := md.getCWData(&fakeCloudWatch{pageSize: 1}, rds)
+	assert.NoError(t, err)
+	md.saveCWData(result, targets, region)
+
+	assert.ElementsMatch(t, expectedAwkwardLabels(), collectLabels(t, region, "test_round_trip_cw"))
+}
+
+func TestSaveNCWDataRoundTrip(t *testing.T) {
+	rds, region := awkwardRegion()
+	md := testMetric("Average")
+	md.OutputName = aws.String("test_round_trip_ncw")
+
+	metrics := []*NonCloudWatchMetric{}
+	for _, rd := range rds {
+		metrics = append(metrics, &NonCloudWatchMetric{
+			Values:     []*float64{aws.Float64(1)},
+			Timestamps: []*time.Time{aws.Time(time.Now())},
+			Statistic:  "Average",
+			Resource:   rd,
+		})
+	}
+	md.saveNCWData(metrics, region)
+
+	assert.ElementsMatch(t, expectedAwkwardLabels(), collectLabels(t, region, "test_round_trip_ncw"))
+}
+
+func TestSaveCWDataUnknownID(t *testing.T) {
+	rds, region := awkwardRegion()
+	md := testMetric("Average")
+	md.OutputName = aws.String("test_unknown_id")
+
+	result := &cloudwatch.GetMetricDataOutput{
+		MetricDataResults: []*cloudwatch.MetricDataResult{
+			{
+				Id:         aws.String("q0"),
+				Values:     []*float64{aws.Float64(1)},
+				Timestamps: []*time.Time{aws.Time(time.Now())},
+			},
+			{
+				Id:         aws.String("unknown"),
+				Values:     []*float64{aws.Float64(1)},
+				Timestamps: []*time.Time{aws.Time(time.Now())},
+			},
+		},
+	}
+	md.saveCWData(result, map[string]*queryTarget{"q0": {resource: rds[0], statistic: "Average"}}, region)
+
+	assert.ElementsMatch(t, expectedAwkwardLabels()[:1], collectLabels(t, region, "test_unknown_id"))
+}
+
+func TestSaveCWDataLatest(t *testing.T) {
+	rds, region := awkwardRegion()
+	md := testMetric("Maximum")
+	md.OutputName = aws.String("test_latest")
+	md.Latest = true
+
+	// a running total whose range spans the start of the month
+	timestamps := []*time.Time{}
+	for _, day := range []int{2, 1} {
+		timestamps = append(timestamps, aws.Time(time.Date(2021, time.March, day, 0, 0, 0, 0, time.UTC)))
+	}
+	for _, day := range []int{28, 27, 26} {
+		timestamps = append(timestamps, aws.Time(time.Date(2021, time.February, day, 0, 0, 0, 0, time.UTC)))
+	}
+	result := &cloudwatch.GetMetricDataOutput{
+		MetricDataResults: []*cloudwatch.MetricDataResult{
+			{
+				Id:         aws.String("q0"),
+				Values:     aws.Float64Slice([]float64{12, 3, 950, 900, 850}),
+				Timestamps: timestamps,
+			},
+		},
+	}
+	md.saveCWData(result, map[string]*queryTarget{"q0": {resource: rds[0], statistic: "Maximum"}}, region)
+
+	exporter.mutex.RLock()
+	collector := exporter.data[region.Key()]["test_latest_max"]
+	exporter.mutex.RUnlock()
+	ch := make(chan prometheus.Metric, 1)
+	collector.Collect(ch)
+	pb := &dto.Metric{}
+	assert.NoError(t, (<-ch).Write(pb))
+	assert.Equal(t, 12.0, pb.GetGauge().GetValue())
+}
+
+func TestSaveAfterRemoveRegion(t *testing.T) {
+	rds, region := awkwardRegion()
+	md := testMetric("Average")
+	md.OutputName = aws.String("test_removed_region")
+
+	result, targets, err := md.getCWData(&fakeCloudWatch{pageSize: 1}, rds)
+	assert.NoError(t, err)
+	// a gather which passed its isStopped check saves after the region was removed
+	RemoveRegion(region)
+	md.saveCWData(result, targets, region)
+
+	exporter.mutex.RLock()
+	defer exporter.mutex.RUnlock()
+	assert.NotContains(t, exporter.data, region.Key())
+}
+
+func TestBuildQuerySplit(t *testing.T) {
+	md := testMetric("Average", "Maximum")
+	md.SplitBy = &SplitDimension{Name: aws.String("Operation"), Values: aws.StringSlice([]string{"GetItem", "Query"})}
+
+	query, targets, err := md.BuildQuery(testResources(2))
+	assert.NoError(t, err)
+	assert.Len(t, query, 8)
+	assert.Len(t, targets, 8)
+
+	splits := map[string]int{}
+	for _, q := range query {
+		target := targets[*q.Id]
+		dimensions := q.MetricStat.Metric.Dimensions
+		assert.Equal(t, "Operation", *dimensions[len(dimensions)-1].Name)
+		assert.Equal(t, target.split, *dimensions[len(dimensions)-1].Value)
+		splits[target.split]++
+	}
+	assert.Equal(t, map[string]int{"GetItem": 4, "Query": 4}, splits)
+}
+
+func TestMetricResources(t *testing.T) {
+	rds := testResources(3)
+	rds[1].Type = aws.String("rds-cluster")
+	md := testMetric("Average")
+
+	assert.Equal(t, rds, md.resources(rds))
+
+	md.ResourceType = aws.String("rds-cluster")
+	assert.Equal(t, []*ResourceDescription{rds[1]}, md.resources(rds))
+
+	md.ResourceType = aws.String("unknown")
+	assert.Empty(t, md.resources(rds))
+
+	md.ResourceType = nil
+	md.ResourceTypes = aws.StringSlice([]string{"ec2", "unknown"})
+	assert.Equal(t, []*ResourceDescription{rds[0], rds[2]}, md.resources(rds))
+}
+
+func TestHasMetricsFor(t *testing.T) {
+	nd := &NamespaceDescription{}
+	assert.False(t, nd.HasMetricsFor("rds-cluster"))
+
+	md := testMetric("Average")
+	md.ResourceType = aws.String("rds")
+	nd.Metrics = []*MetricDescription{md}
+	assert.True(t, nd.HasMetricsFor("rds"))
+	assert.False(t, nd.HasMetricsFor("rds-cluster"))
+
+	nd.Metrics = append(nd.Metrics, testMetric("Average"))
+	assert.True(t, nd.HasMetricsFor("rds-cluster"))
+}
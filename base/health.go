@@ -0,0 +1,56 @@
+package base
+
+import (
+	"fmt"
+	"time"
+)
+
+// MarkDiscovered records that a resource discovery pass of every namespace in the region completed
+func (rd *RegionDescription) MarkDiscovered() {
+	rd.Mutex.Lock()
+	defer rd.Mutex.Unlock()
+	rd.discovered = time.Now()
+}
+
+// markGathered records that a gather of every metric in the region completed.
+//
+// A region without any metrics configured has nothing to poll, so the gather
+// itself counts as a successful poll.
+func (rd *RegionDescription) markGathered() {
+	metrics := 0
+	for _, nd := range rd.Namespaces {
+		nd.Mutex.RLock()
+		metrics += len(nd.Metrics)
+		nd.Mutex.RUnlock()
+	}
+
+	rd.Mutex.Lock()
+	defer rd.Mutex.Unlock()
+	rd.gathered = time.Now()
+	if metrics == 0 {
+		rd.polled = rd.gathered
+	}
+}
+
+// markPolled records a poll of a metric in the region which completed without errors
+func (rd *RegionDescription) markPolled() {
+	rd.Mutex.Lock()
+	defer rd.Mutex.Unlock()
+	rd.polled = time.Now()
+}
+
+// Ready returns an error unless the region completed a resource discovery and a
+// gather, and a metric was polled successfully within maxAge.
+func (rd *RegionDescription) Ready(maxAge time.Duration) error {
+	rd.Mutex.RLock()
+	defer rd.Mutex.RUnlock()
+	switch {
+	case rd.discovered.IsZero():
+		return fmt.Errorf("region %s has not completed a resource discovery yet", rd.Key())
+	case rd.gathered.IsZero():
+		return fmt.Errorf("region %s has not completed a gather yet", rd.Key())
+	case time.Since(rd.polled) > maxAge:
+		return fmt.Errorf("region %s has not been polled successfully for more than %s", rd.Key(), maxAge)
+	}
+	return nil
+}
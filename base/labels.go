@@ -0,0 +1,89 @@
+package base
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// tagLabel returns the sanitised name of the label generated for an AWS tag
+func tagLabel(key string) string {
+	return "tag_" + h.ToPromString(key)
+}
+
+// tagLabelKeys returns the configured tag_labels paired with the name of the
+// label generated for them. Tags which would result in the same label name are
+// only included once.
+func (rd *RegionDescription) tagLabelKeys() ([]string, []string) {
+	if rd.Config == nil {
+		return nil, nil
+	}
+	keys := []string{}
+	names := []string{}
+	seen := map[string]bool{}
+	for _, key := range rd.Config.TagLabels {
+		name := tagLabel(key)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		keys = append(keys, key)
+		names = append(names, name)
+	}
+	return keys, names
+}
+
+// labelNames returns the names of the labels exported for every series of a metric in the region
+func (rd *RegionDescription) labelNames(md *MetricDescription) []string {
+	names := []string{"name", "id", "type", "region", "account_id"}
+	if rd.Config == nil || !rd.Config.DropTagsLabel {
+		names = append(names, "tags")
+	}
+	if md.SplitBy != nil {
+		names = append(names, md.SplitBy.label())
+	}
+	names = append(names, namespaceLabels(md.Namespace)...)
+	_, tagNames := rd.tagLabelKeys()
+	return append(names, tagNames...)
+}
+
+// labelValues returns the values of the labels exported for a series of a
+// resource in the same order as labelNames
+func (rd *RegionDescription) labelValues(md *MetricDescription, l *AwsLabels, resource *ResourceDescription) []string {
+	values := []string{l.Name, l.Id, l.RType, l.Region, l.AccountID}
+	if rd.Config == nil || !rd.Config.DropTagsLabel {
+		values = append(values, l.Tags)
+	}
+	if md.SplitBy != nil {
+		values = append(values, l.Split)
+	}
+	for _, name := range namespaceLabels(md.Namespace) {
+		values = append(values, resource.Labels[name])
+	}
+	keys, _ := rd.tagLabelKeys()
+	if len(keys) == 0 {
+		return values
+	}
+	tagValues := map[string]string{}
+	for _, tag := range resource.Tags {
+		tagValues[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	for _, key := range keys {
+		values = append(values, tagValues[key])
+	}
+	return values
+}
+
+// labelsToString joins the Labels of a resource into a single string so they
+// can be part of the comparable AwsLabels
+func labelsToString(labels map[string]string) string {
+	l := []string{}
+	for name, value := range labels {
+		l = append(l, fmt.Sprintf("%s=%q", name, value))
+	}
+	sort.Strings(l)
+	return strings.Join(l, ",")
+}
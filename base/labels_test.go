@@ -0,0 +1,66 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabels(t *testing.T) {
+	tags := []*TagDescription{
+		{Key: aws.String("Environment"), Value: aws.String("production")},
+		{Key: aws.String("Team"), Value: aws.String("core=ops")},
+	}
+	labels := &AwsLabels{"Average", "web", "i-1", "ec2", "eu-west-1", "123456789012", *TagsToString(tags), "", ""}
+	md := testMetric("Average")
+	resource := &ResourceDescription{Tags: tags}
+
+	rd := &RegionDescription{Config: &Config{TagLabels: []string{"Environment", "Team", "Service", "environment"}}}
+	assert.Equal(t, []string{"name", "id", "type", "region", "account_id", "tags", "tag_environment", "tag_team", "tag_service"}, rd.labelNames(md))
+	assert.Equal(t, []string{"web", "i-1", "ec2", "eu-west-1", "123456789012", "Environment=production,Team=core=ops", "production", "core=ops", ""}, rd.labelValues(md, labels, resource))
+
+	rd.Config.DropTagsLabel = true
+	assert.Equal(t, []string{"name", "id", "type", "region", "account_id", "tag_environment", "tag_team", "tag_service"}, rd.labelNames(md))
+	assert.Equal(t, []string{"web", "i-1", "ec2", "eu-west-1", "123456789012", "production", "core=ops", ""}, rd.labelValues(md, labels, resource))
+}
+
+func TestSplitLabels(t *testing.T) {
+	labels := &AwsLabels{"Average", "orders", "orders", "dynamodb", "eu-west-1", "123456789012", "", "GetItem", ""}
+	md := testMetric("Average")
+	md.SplitBy = &SplitDimension{Name: aws.String("Operation"), Values: aws.StringSlice([]string{"GetItem", "Query"})}
+
+	rd := &RegionDescription{Config: &Config{}}
+	assert.Equal(t, []string{"name", "id", "type", "region", "account_id", "tags", "operation"}, rd.labelNames(md))
+	assert.Equal(t, []string{"orders", "orders", "dynamodb", "eu-west-1", "123456789012", "", "GetItem"}, rd.labelValues(md, labels, &ResourceDescription{}))
+}
+
+func TestNamespaceLabels(t *testing.T) {
+	defer restoreRegistry()()
+	RegisterNamespace("Test/Labels", nil, nil)
+	RegisterLabels("Test/Labels", "replication_group", "node")
+	labels := &AwsLabels{"Average", "cache-0001", "cache-0001/0001", "elasticache-node", "eu-west-1", "123456789012", "", "", ""}
+	md := testMetric("Average")
+	md.Namespace = "Test/Labels"
+
+	rd := &RegionDescription{Config: &Config{DropTagsLabel: true}}
+	assert.Equal(t, []string{"name", "id", "type", "region", "account_id", "replication_group", "node"}, rd.labelNames(md))
+
+	resource := &ResourceDescription{Labels: map[string]string{"node": "0001"}}
+	assert.Equal(t, []string{"cache-0001", "cache-0001/0001", "elasticache-node", "eu-west-1", "123456789012", "", "0001"}, rd.labelValues(md, labels, resource))
+
+	assert.Panics(t, func() { RegisterLabels("Test/Unknown", "label") })
+}
+
+func TestAwsLabelsIncludeResourceLabels(t *testing.T) {
+	rds := testResources(1)
+	zonal := &ResourceDescription{
+		Name:   rds[0].Name,
+		ID:     rds[0].ID,
+		Type:   rds[0].Type,
+		Parent: rds[0].Parent,
+		Labels: map[string]string{"availability_zone": "eu-west-1a"},
+	}
+	assert.NotEqual(t, *rds[0].awsLabels("Sum"), *zonal.awsLabels("Sum"))
+	assert.Equal(t, `availability_zone="eu-west-1a"`, zonal.awsLabels("Sum").Labels)
+}
@@ -1,20 +1,151 @@
 package base
 
-var namespaces = []string{
-	"AWS/Backup",
-	"AWS/ELB",
-	"AWS/NetworkELB",
-	"AWS/ApplicationELB",
-	"AWS/RDS",
-	"AWS/ElastiCache",
-	"AWS/NATGateway",
-	"AWS/EC2",
-	"AWS/S3",
-	"AWS/SQS",
-	"AWS/VPC",
-}
-
-// GetNamespaces returns a list of AWS namespaces which are configured for this exporter
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ResourceListFunc fetches the resources of a namespace in the parent region,
+// stores them in nd.Resources and calls wg.Done when finished
+type ResourceListFunc func(nd *NamespaceDescription, wg *sync.WaitGroup)
+
+type namespaceRegistration struct {
+	metrics            map[string]*MetricDescription
+	createResourceList ResourceListFunc
+	labels             []string
+	global             bool
+}
+
+var (
+	registry      = map[string]*namespaceRegistration{}
+	registryMutex sync.RWMutex
+)
+
+// RegisterNamespace makes an AWS namespace available to the exporter.
+//
+// metrics are the default MetricDescriptions for the namespace and fn is used to
+// discover its resources. It is intended to be called from the init function of
+// the package implementing the namespace and panics if the namespace is registered twice.
+func RegisterNamespace(namespace string, metrics map[string]*MetricDescription, fn ResourceListFunc) {
+	registerNamespace(namespace, metrics, fn, false)
+}
+
+// RegisterGlobalNamespace makes the AWS namespace of a global service, e.g.
+// CloudFront, available to the exporter.
+//
+// Global namespaces are polled once per account through us-east-1, whichever
+// regions are configured, and their series are labelled with region="global".
+func RegisterGlobalNamespace(namespace string, metrics map[string]*MetricDescription, fn ResourceListFunc) {
+	registerNamespace(namespace, metrics, fn, true)
+}
+
+func registerNamespace(namespace string, metrics map[string]*MetricDescription, fn ResourceListFunc, global bool) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	if _, ok := registry[namespace]; ok {
+		panic(fmt.Sprintf("namespace %s is already registered", namespace))
+	}
+	registry[namespace] = &namespaceRegistration{
+		metrics:            metrics,
+		createResourceList: fn,
+		global:             global,
+	}
+}
+
+// isGlobalNamespace reports whether a namespace was registered with RegisterGlobalNamespace
+func isGlobalNamespace(namespace string) bool {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	r, ok := registry[namespace]
+	return ok && r.global
+}
+
+// HasGlobalNamespaces reports whether metrics are configured for any global namespace
+func HasGlobalNamespaces(metrics map[string][]*MetricDescription) bool {
+	for namespace := range metrics {
+		if isGlobalNamespace(namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// RegisterLabels adds labels to every series of a registered namespace. Their
+// values are taken from the Labels of the resource a series belongs to, a
+// resource without a value for a label exports it empty.
+//
+// It panics if the namespace is not registered.
+func RegisterLabels(namespace string, labels ...string) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	r, ok := registry[namespace]
+	if !ok {
+		panic(fmt.Sprintf("namespace %s is not registered", namespace))
+	}
+	r.labels = append(r.labels, labels...)
+}
+
+// namespaceLabels returns the labels registered for a namespace with RegisterLabels
+func namespaceLabels(namespace string) []string {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	if r, ok := registry[namespace]; ok {
+		return r.labels
+	}
+	return nil
+}
+
+// GetNamespaces returns a sorted list of AWS namespaces which are configured for this exporter
 func GetNamespaces() []string {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	namespaces := make([]string, 0, len(registry))
+	for namespace := range registry {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
 	return namespaces
 }
+
+// GetDefaultMetrics returns the default MetricDescriptions of every registered namespace keyed by namespace
+func GetDefaultMetrics() map[string]map[string]*MetricDescription {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	defaults := make(map[string]map[string]*MetricDescription, len(registry))
+	for namespace, r := range registry {
+		defaults[namespace] = r.metrics
+	}
+	return defaults
+}
+
+// CreateResourceList fetches the resources of this namespace using the function registered for it.
+//
+// Namespaces without any metrics configured are skipped.
+func (nd *NamespaceDescription) CreateResourceList(wg *sync.WaitGroup) {
+	defer wg.Done()
+	registryMutex.RLock()
+	r, ok := registry[*nd.Namespace]
+	registryMutex.RUnlock()
+	if !ok {
+		return
+	}
+
+	nd.Mutex.RLock()
+	configured := len(nd.Metrics) > 0
+	nd.Mutex.RUnlock()
+	if !configured {
+		nd.Mutex.Lock()
+		nd.Resources = nil
+		nd.Mutex.Unlock()
+		return
+	}
+
+	start := time.Now()
+	var w sync.WaitGroup
+	w.Add(1)
+	r.createResourceList(nd, &w)
+	w.Wait()
+	nd.observeDiscovery(start)
+}
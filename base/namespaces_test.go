@@ -0,0 +1,43 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+// restoreRegistry returns a func which undoes the registrations made by a test
+func restoreRegistry() func() {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	saved := map[string]*namespaceRegistration{}
+	for namespace, r := range registry {
+		saved[namespace] = r
+	}
+	return func() {
+		registryMutex.Lock()
+		defer registryMutex.Unlock()
+		registry = saved
+	}
+}
+
+func TestGlobalNamespaces(t *testing.T) {
+	defer restoreRegistry()()
+	RegisterNamespace("Test/Regional", nil, nil)
+	RegisterGlobalNamespace("Test/Global", nil, nil)
+
+	regional := &RegionDescription{Region: aws.String("eu-west-1")}
+	assert.NoError(t, regional.CreateNamespaceDescriptions(nil))
+	assert.Contains(t, regional.Namespaces, "Test/Regional")
+	assert.NotContains(t, regional.Namespaces, "Test/Global")
+
+	global := &RegionDescription{Region: aws.String(GlobalRegion)}
+	assert.True(t, global.IsGlobal())
+	assert.NoError(t, global.CreateNamespaceDescriptions(nil))
+	assert.Contains(t, global.Namespaces, "Test/Global")
+	assert.NotContains(t, global.Namespaces, "Test/Regional")
+
+	assert.False(t, HasGlobalNamespaces(map[string][]*MetricDescription{"Test/Regional": nil}))
+	assert.True(t, HasGlobalNamespaces(map[string][]*MetricDescription{"Test/Regional": nil, "Test/Global": nil}))
+}
@@ -1,17 +1,102 @@
 package base
 
 import (
+	"reflect"
 	"sync"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 var (
-	exporter = Exporter{data: make(map[string]BatchCollector)}
+	exporter = Exporter{data: make(map[string]map[string]BatchCollector)}
+
+	apiCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cloudwatch_exporter_api_calls_total",
+		Help: "The number of calls made to the AWS API",
+	}, []string{"service", "operation"})
+	apiErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cloudwatch_exporter_api_errors_total",
+		Help: "The number of calls to the AWS API which failed after all retries",
+	}, []string{"service", "operation"})
+	apiThrottles = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cloudwatch_exporter_api_throttles_total",
+		Help: "The number of attempts to call the AWS API which were throttled",
+	}, []string{"service", "operation"})
+	datapointsRequested = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cloudwatch_exporter_get_metric_data_datapoints_requested_total",
+		Help: "The number of datapoints requested from the GetMetricData API",
+	}, []string{"namespace"})
+	labelErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cloudwatch_exporter_label_errors_total",
+		Help: "The number of results dropped because the series they belong to could not be identified",
+	}, []string{"namespace", "metric"})
+	discoveryDuration = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloudwatch_exporter_discovery_duration_seconds",
+		Help: "How long the last resource discovery of a namespace took",
+	}, []string{"account_id", "region", "namespace"})
+	gatherDuration = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloudwatch_exporter_gather_duration_seconds",
+		Help: "How long the last gather of all the metrics of a namespace took",
+	}, []string{"account_id", "region", "namespace"})
+	resourcesDiscovered = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloudwatch_exporter_resources",
+		Help: "The number of resources found by the last resource discovery of a namespace",
+	}, []string{"account_id", "region", "namespace"})
+	lastSuccessfulPoll = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloudwatch_exporter_last_successful_poll_timestamp_seconds",
+		Help: "The time of the last poll of a metric which completed without errors",
+	}, []string{"account_id", "region", "namespace", "metric"})
+
+	regionVecs = []*prometheus.GaugeVec{discoveryDuration, gatherDuration, resourcesDiscovered, lastSuccessfulPoll}
 )
 
 func init() {
 	prometheus.MustRegister(&exporter)
+	prometheus.MustRegister(apiCalls, apiErrors, apiThrottles, datapointsRequested, labelErrors)
+	for _, v := range regionVecs {
+		prometheus.MustRegister(v)
+	}
+}
+
+// observeAPIAttempt counts throttled attempts to call the AWS API
+func observeAPIAttempt(r *request.Request) {
+	if request.IsErrorThrottle(r.Error) {
+		apiThrottles.WithLabelValues(r.ClientInfo.ServiceName, r.Operation.Name).Inc()
+	}
+}
+
+// observeAPICall counts completed calls to the AWS API and the ones which failed
+func observeAPICall(r *request.Request) {
+	apiCalls.WithLabelValues(r.ClientInfo.ServiceName, r.Operation.Name).Inc()
+	if r.Error != nil {
+		apiErrors.WithLabelValues(r.ClientInfo.ServiceName, r.Operation.Name).Inc()
+	}
+}
+
+// observeDiscovery records the duration and result of a resource discovery
+func (nd *NamespaceDescription) observeDiscovery(start time.Time) {
+	nd.Mutex.RLock()
+	resources := len(nd.Resources)
+	nd.Mutex.RUnlock()
+
+	rd := nd.Parent
+	discoveryDuration.WithLabelValues(*rd.AccountID, *rd.Region, *nd.Namespace).Set(time.Since(start).Seconds())
+	resourcesDiscovered.WithLabelValues(*rd.AccountID, *rd.Region, *nd.Namespace).Set(float64(resources))
+}
+
+// observeGather records the duration of a gather of all the metrics of a namespace
+func (nd *NamespaceDescription) observeGather(start time.Time) {
+	rd := nd.Parent
+	gatherDuration.WithLabelValues(*rd.AccountID, *rd.Region, *nd.Namespace).Set(time.Since(start).Seconds())
+}
+
+// observePoll records the time of a successful poll of a metric
+func (nd *NamespaceDescription) observePoll(md *MetricDescription) {
+	rd := nd.Parent
+	rd.markPolled()
+	lastSuccessfulPoll.WithLabelValues(*rd.AccountID, *rd.Region, *nd.Namespace, *md.OutputName).SetToCurrentTime()
 }
 
 // BatchCollector is a prometheus.Collector which allows a metric to be
@@ -19,11 +104,14 @@ func init() {
 type BatchCollector interface {
 	prometheus.Collector
 	BatchUpdate([]*promMetric)
+
+	// matches reports whether the collector was created with this help and these label names
+	matches(help string, labels []string) bool
 }
 
 // Exporter collects Cloudwatch metrics and exports them using the prometheus.Collector interface
 type Exporter struct {
-	data  map[string]BatchCollector
+	data  map[string]map[string]BatchCollector // Collectors keyed by RegionDescription.Key and then by metric name
 	mutex sync.RWMutex
 }
 
@@ -32,16 +120,77 @@ type Exporter struct {
 func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 	e.mutex.RLock()
 	defer e.mutex.RUnlock()
-	for _, mv := range e.data {
-		mv.Collect(ch)
+	for _, region := range e.data {
+		for _, mv := range region {
+			mv.Collect(ch)
+		}
 	}
 }
 
 // Describe describes all the metrics exported by the CloudWatch exporter.
 // Implements prometheus.Collector.
 func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
-	for _, mv := range e.data {
-		mv.Describe(ch)
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	for _, region := range e.data {
+		for _, mv := range region {
+			mv.Describe(ch)
+		}
+	}
+}
+
+// batchUpdate replaces the data of the named metric in a region, creating its
+// collector first if needed. Sum statistics are exported as counters and
+// every other statistic as a gauge.
+//
+// A collector created with other help or labels, e.g. by a poll which was
+// still running when the metric was changed by a reload, is replaced. Data of
+// regions which were removed is dropped.
+func (e *Exporter) batchUpdate(rd *RegionDescription, stat string, opts prometheus.Opts, labels []string, data []*promMetric) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if rd.removed {
+		return
+	}
+
+	region := rd.Key()
+	if _, ok := e.data[region]; !ok {
+		e.data[region] = make(map[string]BatchCollector)
+	}
+	if c, ok := e.data[region][opts.Name]; !ok || !c.matches(opts.Help, labels) {
+		if stat == "Sum" {
+			e.data[region][opts.Name] = NewBatchCounterVec(opts, labels)
+		} else {
+			e.data[region][opts.Name] = NewBatchGaugeVec(opts, labels)
+		}
+	}
+	e.data[region][opts.Name].BatchUpdate(data)
+}
+
+// retain drops every metric of a region which is not in names
+func (e *Exporter) retain(region string, names map[string]bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	for name := range e.data[region] {
+		if !names[name] {
+			delete(e.data[region], name)
+		}
+	}
+}
+
+// RemoveRegion drops every series exported for a region, including the
+// exporter's own metrics about the region and its cached resource data. Data
+// saved for the region afterwards, by a gather or discovery which was still
+// running, is dropped as well.
+func RemoveRegion(rd *RegionDescription) {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	rd.removed = true
+	delete(exporter.data, rd.Key())
+	removeCachedData(rd)
+
+	for _, v := range regionVecs {
+		v.DeletePartialMatch(prometheus.Labels{"account_id": *rd.AccountID, "region": *rd.Region})
 	}
 }
 
@@ -53,6 +202,8 @@ type promMetric struct {
 // BatchGaugeVec is a prometheus.GaugeVec which implements BatchCollector
 type BatchGaugeVec struct {
 	desc    *prometheus.Desc
+	help    string
+	labels  []string
 	metrics []*promMetric
 	mutex   sync.RWMutex
 }
@@ -79,6 +230,10 @@ func (bgv *BatchGaugeVec) Collect(ch chan<- prometheus.Metric) {
 	}
 }
 
+func (bgv *BatchGaugeVec) matches(help string, labels []string) bool {
+	return bgv.help == help && reflect.DeepEqual(bgv.labels, labels)
+}
+
 // Describe implements prometheus.Describe for BatchGaugeVec
 func (bgv *BatchGaugeVec) Describe(ch chan<- *prometheus.Desc) {
 	ch <- bgv.desc
@@ -89,14 +244,18 @@ func NewBatchGaugeVec(opts prometheus.Opts, labels []string) *BatchGaugeVec {
 	name := prometheus.BuildFQName(opts.Namespace, opts.Subsystem, opts.Name)
 	return &BatchGaugeVec{
 		desc:    prometheus.NewDesc(name, opts.Help, labels, prometheus.Labels{}),
+		help:    opts.Help,
+		labels:  labels,
 		metrics: []*promMetric{},
 	}
 }
 
 // BatchCounterVec is a prometheus.CounterVec which implements BatchCollector
 type BatchCounterVec struct {
-	c     *prometheus.CounterVec
-	mutex sync.RWMutex
+	c      *prometheus.CounterVec
+	help   string
+	labels []string
+	mutex  sync.RWMutex
 }
 
 // BatchUpdate replaces the metric data for the BatchCounterVec with the input data.
@@ -114,6 +273,10 @@ func (bcv *BatchCounterVec) Collect(ch chan<- prometheus.Metric) {
 	bcv.c.Collect(ch)
 }
 
+func (bcv *BatchCounterVec) matches(help string, labels []string) bool {
+	return bcv.help == help && reflect.DeepEqual(bcv.labels, labels)
+}
+
 // Describe implements prometheus.Describe for BatchCounterVec
 func (bcv *BatchCounterVec) Describe(ch chan<- *prometheus.Desc) {
 	bcv.c.Describe(ch)
@@ -131,5 +294,7 @@ func NewBatchCounterVec(opts prometheus.Opts, labels []string) *BatchCounterVec
 			},
 			labels,
 		),
+		help:   opts.Help,
+		labels: labels,
 	}
 }
@@ -0,0 +1,99 @@
+package billing
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+func init() {
+	b.RegisterGlobalNamespace("AWS/Billing", Metrics, CreateResourceList)
+	b.RegisterLabels("AWS/Billing", "currency", "service", "linked_account")
+}
+
+// createResourceDescription creates a resource for a combination of billing dimensions
+func createResourceDescription(nd *b.NamespaceDescription, dimensions map[string]*string) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{}
+	id := []string{}
+	for _, name := range []string{"LinkedAccount", "ServiceName", "Currency"} {
+		if value, ok := dimensions[name]; ok {
+			dd = append(dd, &b.DimensionDescription{
+				Name:  aws.String(name),
+				Value: value,
+			})
+			id = append(id, *value)
+		}
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = aws.String(strings.Join(id, "/"))
+	rd.Name = aws.String("Total")
+	if service, ok := dimensions["ServiceName"]; ok {
+		rd.Name = service
+	}
+	rd.Type = aws.String("billing")
+	rd.Parent = nd
+	rd.Labels = map[string]string{
+		"currency":       aws.StringValue(dimensions["Currency"]),
+		"service":        aws.StringValue(dimensions["ServiceName"]),
+		"linked_account": aws.StringValue(dimensions["LinkedAccount"]),
+	}
+
+	return &rd, nil
+}
+
+// CreateResourceList lists the dimension combinations EstimatedCharges is
+// published for. Billing has no resources to describe, so every combination
+// found by ListMetrics becomes a resource.
+//
+// Charges per service or per linked account are only included if enabled in the billing config.
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating Billing resource list ...")
+
+	config := b.BillingConfig{}
+	if nd.Parent.Config != nil {
+		config = nd.Parent.Config.Billing
+	}
+
+	session := cloudwatch.New(nd.Parent.Session)
+	input := cloudwatch.ListMetricsInput{
+		Namespace:  nd.Namespace,
+		MetricName: aws.String("EstimatedCharges"),
+	}
+	resources := []*b.ResourceDescription{}
+	err := session.ListMetricsPages(&input, func(page *cloudwatch.ListMetricsOutput, lastPage bool) bool {
+		for _, metric := range page.Metrics {
+			dimensions := map[string]*string{}
+			for _, d := range metric.Dimensions {
+				dimensions[*d.Name] = d.Value
+			}
+			if _, ok := dimensions["ServiceName"]; ok && !config.ByService {
+				continue
+			}
+			if _, ok := dimensions["LinkedAccount"]; ok && !config.ByLinkedAccount {
+				continue
+			}
+			r, err := createResourceDescription(nd, dimensions)
+			if err == nil {
+				resources = append(resources, r)
+			}
+			h.LogIfError(err)
+		}
+		return true
+	})
+	h.LogIfError(err)
+
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
@@ -0,0 +1,26 @@
+package billing
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// Metrics is a map of default MetricDescriptions for this namespace
+//
+// EstimatedCharges is a running total which resets at the start of the month,
+// the latest datapoint of the range is exported rather than the maximum so a
+// reset is reported as soon as it happens.
+var Metrics = map[string]*b.MetricDescription{
+	"EstimatedCharges": {
+		Help:          aws.String("The estimated charges for AWS usage since the start of the billing month"),
+		OutputName:    aws.String("billing_estimated_charges"),
+		Statistic:     h.StringPointers("Maximum"),
+		PeriodSeconds: 60 * 60 * 24,
+		RangeSeconds:  60 * 60 * 24 * 7,
+		Latest:        true,
+		Kind:          aws.String(b.CLOUDWATCH_KIND),
+		Dimensions:    []*cloudwatch.Dimension{},
+	},
+}
@@ -0,0 +1,90 @@
+package cloudfront
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
+func init() {
+	b.RegisterGlobalNamespace("AWS/CloudFront", Metrics, CreateResourceList)
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, ds *cloudfront.DistributionSummary) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("DistributionId"),
+			Value: ds.Id,
+		},
+		{
+			Name:  aws.String("Region"),
+			Value: aws.String("Global"),
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = ds.Id
+	rd.Name = ds.DomainName
+	rd.Type = aws.String("cloudfront")
+	rd.Parent = nd
+	rd.Tags = tags
+
+	return &rd, nil
+}
+
+// CreateResourceList fetches a list of all CloudFront distributions of the account
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating CloudFront resource list ...")
+	session := cloudfront.New(nd.Parent.Session)
+	input := cloudfront.ListDistributionsInput{}
+	distributions := []*cloudfront.DistributionSummary{}
+	err := session.ListDistributionsPages(&input, func(page *cloudfront.ListDistributionsOutput, lastPage bool) bool {
+		if page.DistributionList != nil {
+			distributions = append(distributions, page.DistributionList.Items...)
+		}
+		return true
+	})
+	h.LogIfError(err)
+
+	var w sync.WaitGroup
+	w.Add(len(distributions))
+	ch := make(chan *b.ResourceDescription, len(distributions))
+	for _, ds := range distributions {
+		go func(ds *cloudfront.DistributionSummary, wg *sync.WaitGroup) {
+			defer wg.Done()
+			input := cloudfront.ListTagsForResourceInput{
+				Resource: ds.ARN,
+			}
+			tags, err := session.ListTagsForResource(&input)
+			h.LogIfError(err)
+
+			tl, found := nd.Parent.TagsFound(tags)
+
+			if found {
+				if r, err := createResourceDescription(nd, tl, ds); err == nil {
+					ch <- r
+				}
+				h.LogIfError(err)
+			}
+		}(ds, &w)
+	}
+	w.Wait()
+	close(ch)
+
+	resources := []*b.ResourceDescription{}
+	for r := range ch {
+		resources = append(resources, r)
+	}
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
@@ -0,0 +1,44 @@
+package cloudfront
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"4xxErrorRate": {
+		Help:       aws.String("The percentage of all viewer requests for which the response's HTTP status code is 4xx"),
+		OutputName: aws.String("cloudfront_4xx_error_rate"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"5xxErrorRate": {
+		Help:       aws.String("The percentage of all viewer requests for which the response's HTTP status code is 5xx"),
+		OutputName: aws.String("cloudfront_5xx_error_rate"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"BytesDownloaded": {
+		Help:       aws.String("The total number of bytes downloaded by viewers for GET, HEAD, and OPTIONS requests"),
+		OutputName: aws.String("cloudfront_bytes_downloaded"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"Requests": {
+		Help:       aws.String("The total number of viewer requests received by CloudFront, for all HTTP methods and for both HTTP and HTTPS requests"),
+		OutputName: aws.String("cloudfront_requests"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
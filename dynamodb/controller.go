@@ -0,0 +1,146 @@
+package dynamodb
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+func init() {
+	b.RegisterNamespace("AWS/DynamoDB", Metrics, CreateResourceList)
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, td *dynamodb.TableDescription) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("TableName"),
+			Value: td.TableName,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = td.TableArn
+	rd.Name = td.TableName
+	rd.Type = aws.String("dynamodb")
+	rd.Parent = nd
+	rd.Tags = tags
+
+	return &rd, nil
+}
+
+func createIndexResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, td *dynamodb.TableDescription, gsi *dynamodb.GlobalSecondaryIndexDescription) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("TableName"),
+			Value: td.TableName,
+		},
+		{
+			Name:  aws.String("GlobalSecondaryIndexName"),
+			Value: gsi.IndexName,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = gsi.IndexArn
+	rd.Name = gsi.IndexName
+	rd.Type = aws.String("dynamodb-gsi")
+	rd.Parent = nd
+	rd.Tags = tags
+
+	return &rd, nil
+}
+
+// listTags fetches every tag of a table, following NextToken as there is no paginator for ListTagsOfResource
+func listTags(session *dynamodb.DynamoDB, arn *string) (*dynamodb.ListTagsOfResourceOutput, error) {
+	tags := &dynamodb.ListTagsOfResourceOutput{}
+	input := dynamodb.ListTagsOfResourceInput{
+		ResourceArn: arn,
+	}
+	for {
+		page, err := session.ListTagsOfResource(&input)
+		if err != nil {
+			return tags, err
+		}
+		tags.Tags = append(tags.Tags, page.Tags...)
+		if page.NextToken == nil {
+			return tags, nil
+		}
+		input.NextToken = page.NextToken
+	}
+}
+
+// CreateResourceList fetches a list of all DynamoDB tables and their global secondary indexes in the region
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating DynamoDB resource list ...")
+	session := dynamodb.New(nd.Parent.Session)
+	input := dynamodb.ListTablesInput{}
+	tableNames := []*string{}
+	err := session.ListTablesPages(&input, func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+		tableNames = append(tableNames, page.TableNames...)
+		return true
+	})
+	h.LogIfError(err)
+
+	var w sync.WaitGroup
+	w.Add(len(tableNames))
+	// A table is sent along with its indexes so the channel can't fill up
+	ch := make(chan []*b.ResourceDescription, len(tableNames))
+	for _, tn := range tableNames {
+		go func(tn *string, wg *sync.WaitGroup) {
+			defer wg.Done()
+			input := dynamodb.DescribeTableInput{
+				TableName: tn,
+			}
+			table, err := session.DescribeTable(&input)
+			if err != nil {
+				h.LogIfError(err)
+				return
+			}
+			td := table.Table
+
+			tags, err := listTags(session, td.TableArn)
+			h.LogIfError(err)
+
+			tl, found := nd.Parent.TagsFound(tags)
+
+			if found {
+				resources := []*b.ResourceDescription{}
+				if r, err := createResourceDescription(nd, tl, td); err == nil {
+					resources = append(resources, r)
+				}
+				h.LogIfError(err)
+
+				for _, gsi := range td.GlobalSecondaryIndexes {
+					r, err := createIndexResourceDescription(nd, tl, td, gsi)
+					if err == nil {
+						resources = append(resources, r)
+					}
+					h.LogIfError(err)
+				}
+				ch <- resources
+			}
+		}(tn, &w)
+	}
+	w.Wait()
+	close(ch)
+
+	resources := []*b.ResourceDescription{}
+	for r := range ch {
+		resources = append(resources, r...)
+	}
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
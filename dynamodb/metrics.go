@@ -0,0 +1,91 @@
+package dynamodb
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// operations generates a series per DynamoDB operation for metrics which are reported per operation
+var operations = &b.SplitDimension{
+	Name: aws.String("Operation"),
+	Values: h.StringPointers(
+		"BatchGetItem",
+		"BatchWriteItem",
+		"DeleteItem",
+		"GetItem",
+		"PutItem",
+		"Query",
+		"Scan",
+		"TransactGetItems",
+		"TransactWriteItems",
+		"UpdateItem",
+	),
+}
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"ConsumedReadCapacityUnits": {
+		Help:       aws.String("The number of read capacity units consumed over the specified time period"),
+		OutputName: aws.String("dynamodb_consumed_read_capacity_units"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"ConsumedWriteCapacityUnits": {
+		Help:       aws.String("The number of write capacity units consumed over the specified time period"),
+		OutputName: aws.String("dynamodb_consumed_write_capacity_units"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"ProvisionedReadCapacityUnits": {
+		Help:       aws.String("The number of provisioned read capacity units for a table or a global secondary index"),
+		OutputName: aws.String("dynamodb_provisioned_read_capacity_units"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"ProvisionedWriteCapacityUnits": {
+		Help:       aws.String("The number of provisioned write capacity units for a table or a global secondary index"),
+		OutputName: aws.String("dynamodb_provisioned_write_capacity_units"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"SuccessfulRequestLatency": {
+		Help:         aws.String("The latency in milliseconds of successful requests to DynamoDB per operation"),
+		OutputName:   aws.String("dynamodb_successful_request_latency"),
+		Statistic:    h.StringPointers("Average", "Maximum"),
+		Kind:         aws.String(b.CLOUDWATCH_KIND),
+		ResourceType: aws.String("dynamodb"),
+
+		Dimensions: []*cloudwatch.Dimension{},
+		SplitBy:    operations,
+	},
+	"SystemErrors": {
+		Help:         aws.String("The number of requests to DynamoDB that generate an HTTP 500 status code per operation"),
+		OutputName:   aws.String("dynamodb_system_errors"),
+		Statistic:    h.StringPointers("Sum"),
+		Kind:         aws.String(b.CLOUDWATCH_KIND),
+		ResourceType: aws.String("dynamodb"),
+
+		Dimensions: []*cloudwatch.Dimension{},
+		SplitBy:    operations,
+	},
+	"ThrottledRequests": {
+		Help:         aws.String("Requests to DynamoDB that exceed the provisioned throughput limits on a resource per operation"),
+		OutputName:   aws.String("dynamodb_throttled_requests"),
+		Statistic:    h.StringPointers("Sum"),
+		Kind:         aws.String(b.CLOUDWATCH_KIND),
+		ResourceType: aws.String("dynamodb"),
+
+		Dimensions: []*cloudwatch.Dimension{},
+		SplitBy:    operations,
+	},
+}
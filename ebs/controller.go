@@ -0,0 +1,97 @@
+package ebs
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// volumes holds the discovered ec2.Volume of every resource, the size and
+// provisioned IOPS are read from it
+var volumes = b.NewResourceCache()
+
+func init() {
+	b.RegisterNamespace("AWS/EBS", Metrics, CreateResourceList)
+	b.RegisterLabels("AWS/EBS", "instance_id", "device")
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, volume *ec2.Volume) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("VolumeId"),
+			Value: volume.VolumeId,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	tl := []*b.TagDescription{}
+	tags := make(map[string]*string)
+	for _, t := range volume.Tags {
+		tags[*t.Key] = t.Value
+		tl = append(tl, &b.TagDescription{Key: t.Key, Value: t.Value})
+	}
+	rd.Tags = tl
+
+	// Multi-Attach volumes can be attached to several instances at once
+	instances := []string{}
+	devices := []string{}
+	for _, attachment := range volume.Attachments {
+		instances = append(instances, aws.StringValue(attachment.InstanceId))
+		devices = append(devices, aws.StringValue(attachment.Device))
+	}
+	sort.Strings(instances)
+	sort.Strings(devices)
+	rd.Labels = map[string]string{
+		"instance_id": strings.Join(instances, ","),
+		"device":      strings.Join(devices, ","),
+	}
+
+	rd.ID = volume.VolumeId
+	rd.Name = volume.VolumeId
+	if name, ok := tags["Name"]; ok {
+		rd.Name = name
+	}
+	rd.Type = aws.String("ebs")
+	rd.Parent = nd
+
+	return &rd, nil
+}
+
+// CreateResourceList fetches a list of all EBS volumes in the parent region
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating EBS resource list ...")
+
+	session := ec2.New(nd.Parent.Session)
+	input := ec2.DescribeVolumesInput{
+		Filters: nd.Parent.Filters,
+	}
+	resources := []*b.ResourceDescription{}
+	data := map[string]interface{}{}
+	err := session.DescribeVolumesPages(&input, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
+		for _, volume := range page.Volumes {
+			r, err := createResourceDescription(nd, volume)
+			if err == nil {
+				resources = append(resources, r)
+				data[*r.ID] = volume
+			}
+			h.LogIfError(err)
+		}
+		return true
+	})
+	h.LogIfError(err)
+	volumes.Replace(nd.Parent, data)
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
@@ -0,0 +1,114 @@
+package ebs
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// gatherVolumeAttribute returns the attribute selected by value for every
+// volume in rds as described by the last discovery, skipping volumes for which
+// it is not set
+func gatherVolumeAttribute(rds []*b.ResourceDescription, value func(*ec2.Volume) *int64) ([]*b.NonCloudWatchMetric, error) {
+	result := []*b.NonCloudWatchMetric{}
+	for _, rd := range rds {
+		volume, ok := volumes.Get(rd)
+		if !ok {
+			continue
+		}
+		v := value(volume.(*ec2.Volume))
+		if v == nil {
+			continue
+		}
+		metric := b.NonCloudWatchMetric{
+			Timestamps: []*time.Time{aws.Time(time.Now())},
+			Statistic:  "Average",
+			Resource:   rd,
+			Values:     []*float64{aws.Float64(float64(*v))},
+		}
+		result = append(result, &metric)
+	}
+
+	return result, nil
+}
+
+func gatherVolumeSizeFunc(rds []*b.ResourceDescription, start time.Time, end time.Time) ([]*b.NonCloudWatchMetric, error) {
+	return gatherVolumeAttribute(rds, func(v *ec2.Volume) *int64 {
+		if v.Size == nil {
+			return nil
+		}
+		// Size is reported in GiB
+		return aws.Int64(*v.Size * 1024 * 1024 * 1024)
+	})
+}
+
+func gatherVolumeIopsFunc(rds []*b.ResourceDescription, start time.Time, end time.Time) ([]*b.NonCloudWatchMetric, error) {
+	return gatherVolumeAttribute(rds, func(v *ec2.Volume) *int64 { return v.Iops })
+}
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"BurstBalance": {
+		Help:       aws.String("The percentage of I/O credits (for gp2) or throughput credits (for st1 and sc1) remaining in the burst bucket"),
+		OutputName: aws.String("ebs_burst_balance"),
+		Statistic:  h.StringPointers("Average", "Minimum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"VolumeIdleTime": {
+		Help:       aws.String("The total number of seconds in the period when no read or write operations were submitted"),
+		OutputName: aws.String("ebs_volume_idle_time"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"VolumeQueueLength": {
+		Help:       aws.String("The number of read and write operation requests waiting to be completed"),
+		OutputName: aws.String("ebs_volume_queue_length"),
+		Statistic:  h.StringPointers("Average", "Maximum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"VolumeReadOps": {
+		Help:       aws.String("The total number of read operations"),
+		OutputName: aws.String("ebs_volume_read_ops"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"VolumeWriteOps": {
+		Help:       aws.String("The total number of write operations"),
+		OutputName: aws.String("ebs_volume_write_ops"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"VolumeSize": {
+		Help:       aws.String("The provisioned size of the volume in bytes"),
+		OutputName: aws.String("ebs_volume_size_bytes"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.NON_CLOUDWATCH_KIND),
+		GatherFunc: gatherVolumeSizeFunc,
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"VolumeIops": {
+		Help:       aws.String("The provisioned IOPS of the volume, or the baseline IOPS of gp2 volumes"),
+		OutputName: aws.String("ebs_volume_provisioned_iops"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.NON_CLOUDWATCH_KIND),
+		GatherFunc: gatherVolumeIopsFunc,
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
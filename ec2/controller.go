@@ -5,15 +5,16 @@ import (
 	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
 	log "github.com/sirupsen/logrus"
 
-	"fmt"
-	"sort"
-	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 )
 
+func init() {
+	b.RegisterNamespace("AWS/EC2", Metrics, CreateResourceList)
+}
+
 func createResourceDescription(nd *b.NamespaceDescription, instance *ec2.Instance) (*b.ResourceDescription, error) {
 	rd := b.ResourceDescription{}
 	dd := []*b.DimensionDescription{
@@ -26,21 +27,13 @@ func createResourceDescription(nd *b.NamespaceDescription, instance *ec2.Instanc
 		return nil, err
 	}
 
-	tl := []string{}
+	tl := []*b.TagDescription{}
 	tags := make(map[string]*string)
 	for _, t := range instance.Tags {
 		tags[*t.Key] = t.Value
-		ts := fmt.Sprintf("%s=%s", *t.Key, *t.Value)
-		tl = append(tl, ts)
-	}
-
-	sort.Strings(tl)
-
-	if len(tl) < 1 {
-		rd.Tags = aws.String("")
-	} else {
-		rd.Tags = aws.String(strings.Join(tl, ","))
+		tl = append(tl, &b.TagDescription{Key: t.Key, Value: t.Value})
 	}
+	rd.Tags = tl
 
 	rd.ID = instance.InstanceId
 	rd.Name = instance.InstanceId
@@ -62,18 +55,20 @@ func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 	input := ec2.DescribeInstancesInput{
 		Filters: nd.Parent.Filters,
 	}
-	result, err := session.DescribeInstances(&input)
-	h.LogIfError(err)
-
 	resources := []*b.ResourceDescription{}
-	for _, reservation := range result.Reservations {
-		for _, instance := range reservation.Instances {
-			if r, err := createResourceDescription(nd, instance); err == nil {
-				resources = append(resources, r)
+	err := session.DescribeInstancesPages(&input, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				r, err := createResourceDescription(nd, instance)
+				if err == nil {
+					resources = append(resources, r)
+				}
+				h.LogIfError(err)
 			}
-			h.LogIfError(err)
 		}
-	}
+		return true
+	})
+	h.LogIfError(err)
 	nd.Mutex.Lock()
 	nd.Resources = resources
 	nd.Mutex.Unlock()
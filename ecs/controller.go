@@ -0,0 +1,156 @@
+package ecs
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// DescribeServices accepts at most 10 services per call
+const maxServicesPerCall = 10
+
+// services holds the discovered ecs.Service of every resource, the task counts
+// are read from it
+var services = b.NewResourceCache()
+
+func init() {
+	b.RegisterNamespace("AWS/ECS", Metrics, CreateResourceList)
+}
+
+// clusterName returns the name of a cluster given its ARN
+func clusterName(clusterArn *string) *string {
+	return h.GetLastStringElement(strings.Split(*clusterArn, "/"))
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, service *ecs.Service) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("ClusterName"),
+			Value: clusterName(service.ClusterArn),
+		},
+		{
+			Name:  aws.String("ServiceName"),
+			Value: service.ServiceName,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = service.ServiceArn
+	rd.Name = service.ServiceName
+	rd.Type = aws.String("ecs-service")
+	rd.Parent = nd
+	rd.Tags = tags
+
+	return &rd, nil
+}
+
+// describeServices describes the input services of a cluster in batches of maxServicesPerCall
+func describeServices(session *ecs.ECS, cluster *string, serviceArns []*string) ([]*ecs.Service, error) {
+	result := []*ecs.Service{}
+	for i := 0; i < len(serviceArns); i += maxServicesPerCall {
+		end := i + maxServicesPerCall
+		if end > len(serviceArns) {
+			end = len(serviceArns)
+		}
+		input := ecs.DescribeServicesInput{
+			Cluster:  cluster,
+			Services: serviceArns[i:end],
+		}
+		output, err := session.DescribeServices(&input)
+		if err != nil {
+			return result, err
+		}
+		for _, failure := range output.Failures {
+			h.LogIfError(fmt.Errorf("error describing ECS service %s: %s", aws.StringValue(failure.Arn), aws.StringValue(failure.Reason)))
+		}
+		result = append(result, output.Services...)
+	}
+	return result, nil
+}
+
+// listServices fetches every service of a cluster
+func listServices(session *ecs.ECS, cluster *string) ([]*ecs.Service, error) {
+	input := ecs.ListServicesInput{
+		Cluster: cluster,
+	}
+	serviceArns := []*string{}
+	err := session.ListServicesPages(&input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+		serviceArns = append(serviceArns, page.ServiceArns...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return describeServices(session, cluster, serviceArns)
+}
+
+// CreateResourceList fetches a list of all ECS services of every cluster in the region
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating ECS resource list ...")
+	session := ecs.New(nd.Parent.Session)
+	input := ecs.ListClustersInput{}
+	clusterArns := []*string{}
+	err := session.ListClustersPages(&input, func(page *ecs.ListClustersOutput, lastPage bool) bool {
+		clusterArns = append(clusterArns, page.ClusterArns...)
+		return true
+	})
+	h.LogIfError(err)
+
+	found := []*ecs.Service{}
+	for _, cluster := range clusterArns {
+		s, err := listServices(session, cluster)
+		h.LogIfError(err)
+		found = append(found, s...)
+	}
+
+	var w sync.WaitGroup
+	w.Add(len(found))
+	ch := make(chan *b.ResourceDescription, len(found))
+	for _, service := range found {
+		go func(service *ecs.Service, wg *sync.WaitGroup) {
+			defer wg.Done()
+			input := ecs.ListTagsForResourceInput{
+				ResourceArn: service.ServiceArn,
+			}
+			tags, err := session.ListTagsForResource(&input)
+			h.LogIfError(err)
+
+			tl, found := nd.Parent.TagsFound(tags)
+
+			if found {
+				if r, err := createResourceDescription(nd, tl, service); err == nil {
+					ch <- r
+				}
+				h.LogIfError(err)
+			}
+		}(service, &w)
+	}
+	w.Wait()
+	close(ch)
+
+	byArn := map[string]*ecs.Service{}
+	for _, service := range found {
+		byArn[*service.ServiceArn] = service
+	}
+	resources := []*b.ResourceDescription{}
+	data := map[string]interface{}{}
+	for r := range ch {
+		resources = append(resources, r)
+		data[*r.ID] = byArn[*r.ID]
+	}
+	services.Replace(nd.Parent, data)
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
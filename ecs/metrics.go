@@ -0,0 +1,92 @@
+package ecs
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// gatherTaskCount returns the task count selected by count for every service
+// in rds as described by the last discovery
+func gatherTaskCount(rds []*b.ResourceDescription, count func(*ecs.Service) *int64) ([]*b.NonCloudWatchMetric, error) {
+	result := []*b.NonCloudWatchMetric{}
+	for _, rd := range rds {
+		service, ok := services.Get(rd)
+		if !ok {
+			continue
+		}
+		metric := b.NonCloudWatchMetric{
+			Timestamps: []*time.Time{aws.Time(time.Now())},
+			Statistic:  "Average",
+			Resource:   rd,
+			Values:     []*float64{aws.Float64(float64(aws.Int64Value(count(service.(*ecs.Service)))))},
+		}
+		result = append(result, &metric)
+	}
+
+	return result, nil
+}
+
+func gatherRunningCountFunc(rds []*b.ResourceDescription, start time.Time, end time.Time) ([]*b.NonCloudWatchMetric, error) {
+	return gatherTaskCount(rds, func(s *ecs.Service) *int64 { return s.RunningCount })
+}
+
+func gatherDesiredCountFunc(rds []*b.ResourceDescription, start time.Time, end time.Time) ([]*b.NonCloudWatchMetric, error) {
+	return gatherTaskCount(rds, func(s *ecs.Service) *int64 { return s.DesiredCount })
+}
+
+func gatherPendingCountFunc(rds []*b.ResourceDescription, start time.Time, end time.Time) ([]*b.NonCloudWatchMetric, error) {
+	return gatherTaskCount(rds, func(s *ecs.Service) *int64 { return s.PendingCount })
+}
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"CPUUtilization": {
+		Help:       aws.String("The percentage of CPU units reserved by the service's tasks that is used"),
+		OutputName: aws.String("ecs_cpu_utilization"),
+		Statistic:  h.StringPointers("Average", "Maximum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"MemoryUtilization": {
+		Help:       aws.String("The percentage of memory reserved by the service's tasks that is used"),
+		OutputName: aws.String("ecs_memory_utilization"),
+		Statistic:  h.StringPointers("Average", "Maximum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"RunningTaskCount": {
+		Help:       aws.String("The number of tasks of the service that are in the RUNNING state"),
+		OutputName: aws.String("ecs_running_task_count"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.NON_CLOUDWATCH_KIND),
+		GatherFunc: gatherRunningCountFunc,
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"DesiredTaskCount": {
+		Help:       aws.String("The number of tasks of the service that should be running"),
+		OutputName: aws.String("ecs_desired_task_count"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.NON_CLOUDWATCH_KIND),
+		GatherFunc: gatherDesiredCountFunc,
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"PendingTaskCount": {
+		Help:       aws.String("The number of tasks of the service that are in the PENDING state"),
+		OutputName: aws.String("ecs_pending_task_count"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.NON_CLOUDWATCH_KIND),
+		GatherFunc: gatherPendingCountFunc,
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
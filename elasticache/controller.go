@@ -12,7 +12,18 @@ import (
 	"github.com/aws/aws-sdk-go/service/elasticache"
 )
 
-func createResourceDescription(nd *b.NamespaceDescription, tags *string, cc *elasticache.CacheCluster) (*b.ResourceDescription, error) {
+const (
+	clusterType          = "elasticache"
+	nodeType             = "elasticache-node"
+	replicationGroupType = "elasticache-replication-group"
+)
+
+func init() {
+	b.RegisterNamespace("AWS/ElastiCache", Metrics, CreateResourceList)
+	b.RegisterLabels("AWS/ElastiCache", "replication_group", "node")
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, cc *elasticache.CacheCluster) (*b.ResourceDescription, error) {
 	rd := b.ResourceDescription{}
 	dd := []*b.DimensionDescription{
 		{
@@ -24,28 +35,103 @@ func createResourceDescription(nd *b.NamespaceDescription, tags *string, cc *ela
 	h.LogIfError(err)
 	rd.ID = cc.CacheClusterId
 	rd.Name = cc.CacheClusterId
-	rd.Type = aws.String("elasticache")
+	rd.Type = aws.String(clusterType)
 	rd.Parent = nd
 	rd.Tags = tags
+	rd.Labels = map[string]string{
+		"replication_group": aws.StringValue(cc.ReplicationGroupId),
+	}
 
 	return &rd, nil
 }
 
-// CreateResourceList fetches a list of all Elasticache clusters in the parent region
+func createNodeResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, cc *elasticache.CacheCluster, node *elasticache.CacheNode) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("CacheClusterId"),
+			Value: cc.CacheClusterId,
+		},
+		{
+			Name:  aws.String("CacheNodeId"),
+			Value: node.CacheNodeId,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = aws.String(strings.Join([]string{*cc.CacheClusterId, *node.CacheNodeId}, "/"))
+	rd.Name = cc.CacheClusterId
+	rd.Type = aws.String(nodeType)
+	rd.Parent = nd
+	rd.Tags = tags
+	rd.Labels = map[string]string{
+		"replication_group": aws.StringValue(cc.ReplicationGroupId),
+		"node":              *node.CacheNodeId,
+	}
+
+	return &rd, nil
+}
+
+func createReplicationGroupResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, rg *elasticache.ReplicationGroup) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("ReplicationGroupId"),
+			Value: rg.ReplicationGroupId,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = rg.ReplicationGroupId
+	rd.Name = rg.ReplicationGroupId
+	rd.Type = aws.String(replicationGroupType)
+	rd.Parent = nd
+	rd.Tags = tags
+	rd.Labels = map[string]string{
+		"replication_group": *rg.ReplicationGroupId,
+	}
+
+	return &rd, nil
+}
+
+// CreateResourceList fetches a list of all Elasticache clusters, their nodes
+// and replication groups in the parent region. Replication groups are only
+// fetched if a configured metric applies to them.
 func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 	defer wg.Done()
 	log.Debug("Creating Elasticache resource list ...")
 
 	session := elasticache.New(nd.Parent.Session)
-	input := elasticache.DescribeCacheClustersInput{}
-	result, err := session.DescribeCacheClusters(&input)
+	input := elasticache.DescribeCacheClustersInput{
+		ShowCacheNodeInfo: aws.Bool(true),
+	}
+	clusters := []*elasticache.CacheCluster{}
+	err := session.DescribeCacheClustersPages(&input, func(page *elasticache.DescribeCacheClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, page.CacheClusters...)
+		return true
+	})
 	h.LogIfError(err)
+
+	groups := []*elasticache.ReplicationGroup{}
+	if nd.HasMetricsFor(replicationGroupType) {
+		groupInput := elasticache.DescribeReplicationGroupsInput{}
+		err = session.DescribeReplicationGroupsPages(&groupInput, func(page *elasticache.DescribeReplicationGroupsOutput, lastPage bool) bool {
+			groups = append(groups, page.ReplicationGroups...)
+			return true
+		})
+		h.LogIfError(err)
+	}
 	service := "elasticache"
 
 	var w sync.WaitGroup
-	w.Add(len(result.CacheClusters))
-	ch := make(chan *b.ResourceDescription, len(result.CacheClusters))
-	for _, cc := range result.CacheClusters {
+	w.Add(len(clusters) + len(groups))
+	// A cluster is sent along with its nodes so the channel can't fill up
+	ch := make(chan []*b.ResourceDescription, len(clusters)+len(groups))
+	for _, cc := range clusters {
 		go func(cc *elasticache.CacheCluster, wg *sync.WaitGroup) {
 			defer wg.Done()
 
@@ -60,22 +146,55 @@ func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 			h.LogIfError(err)
 
 			tl, found := nd.Parent.TagsFound(tags)
-			ts := b.TagsToString(tl)
 
 			if found {
-				if r, err := createResourceDescription(nd, ts, cc); err == nil {
-					ch <- r
+				resources := []*b.ResourceDescription{}
+				if r, err := createResourceDescription(nd, tl, cc); err == nil {
+					resources = append(resources, r)
 				}
 				h.LogIfError(err)
+
+				for _, node := range cc.CacheNodes {
+					r, err := createNodeResourceDescription(nd, tl, cc, node)
+					if err == nil {
+						resources = append(resources, r)
+					}
+					h.LogIfError(err)
+				}
+				ch <- resources
 			}
 		}(cc, &w)
 	}
+	for _, rg := range groups {
+		go func(rg *elasticache.ReplicationGroup, wg *sync.WaitGroup) {
+			defer wg.Done()
+
+			resource := strings.Join([]string{"replicationgroup", *rg.ReplicationGroupId}, ":")
+			arn, err := nd.Parent.BuildARN(&service, &resource)
+			h.LogIfError(err)
+
+			input := elasticache.ListTagsForResourceInput{
+				ResourceName: aws.String(arn),
+			}
+			tags, err := session.ListTagsForResource(&input)
+			h.LogIfError(err)
+
+			tl, found := nd.Parent.TagsFound(tags)
+
+			if found {
+				if r, err := createReplicationGroupResourceDescription(nd, tl, rg); err == nil {
+					ch <- []*b.ResourceDescription{r}
+				}
+				h.LogIfError(err)
+			}
+		}(rg, &w)
+	}
 	w.Wait()
 	close(ch)
 
 	resources := []*b.ResourceDescription{}
 	for r := range ch {
-		resources = append(resources, r)
+		resources = append(resources, r...)
 	}
 	nd.Mutex.Lock()
 	nd.Resources = resources
@@ -11,7 +11,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/elb"
 )
 
-func createResourceDescription(nd *b.NamespaceDescription, tags *string, td *elb.TagDescription) (*b.ResourceDescription, error) {
+func init() {
+	b.RegisterNamespace("AWS/ELB", Metrics, CreateResourceList)
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, td *elb.TagDescription) (*b.ResourceDescription, error) {
 	rd := b.ResourceDescription{}
 	dd := []*b.DimensionDescription{
 		{
@@ -36,29 +40,45 @@ func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 	log.Debug("Creating Classic LB resource list ...")
 	session := elb.New(nd.Parent.Session)
 	input := elb.DescribeLoadBalancersInput{}
-	result, err := session.DescribeLoadBalancers(&input)
-	h.LogIfError(err)
-
 	resourceList := []*string{}
-	for _, lb := range result.LoadBalancerDescriptions {
-		resourceList = append(resourceList, lb.LoadBalancerName)
-	}
+	err := session.DescribeLoadBalancersPages(&input, func(page *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
+		for _, lb := range page.LoadBalancerDescriptions {
+			resourceList = append(resourceList, lb.LoadBalancerName)
+		}
+		return true
+	})
+	h.LogIfError(err)
 	if len(resourceList) <= 0 {
 		return
 	}
 
-	dti := elb.DescribeTagsInput{
-		LoadBalancerNames: resourceList,
+	// The AWS ELB API has a limit of 20 resources which can be described in one request
+	chunkSize := 20
+	tagDescriptions := []*elb.TagDescription{}
+	for i := 0; i < len(resourceList); i += chunkSize {
+		end := i + chunkSize
+		if end > len(resourceList) {
+			end = len(resourceList)
+		}
+
+		dti := elb.DescribeTagsInput{
+			LoadBalancerNames: resourceList[i:end],
+		}
+		tags, err := session.DescribeTags(&dti)
+		h.LogIfError(err)
+		if err != nil {
+			// Keep the previous resources rather than dropping the load
+			// balancers of the failed request
+			return
+		}
+		tagDescriptions = append(tagDescriptions, tags.TagDescriptions...)
 	}
-	tags, err := session.DescribeTags(&dti)
-	h.LogIfError(err)
 
 	resources := []*b.ResourceDescription{}
-	for _, td := range tags.TagDescriptions {
+	for _, td := range tagDescriptions {
 		tl, found := nd.Parent.TagsFound(td)
-		ts := b.TagsToString(tl)
 		if found {
-			if r, err := createResourceDescription(nd, ts, td); err == nil {
+			if r, err := createResourceDescription(nd, tl, td); err == nil {
 				resources = append(resources, r)
 			}
 			h.LogIfError(err)
@@ -6,6 +6,7 @@ import (
 	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
 	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	log "github.com/sirupsen/logrus"
 
@@ -13,17 +14,36 @@ import (
 	"sync"
 )
 
-func createResourceDescription(nd *b.NamespaceDescription, tags *string, td *elbv2.TagDescription) (*b.ResourceDescription, error) {
-	lbID := strings.Split(*td.ResourceArn, "loadbalancer/")[1]
+const (
+	albType            = "lb-application"
+	nlbType            = "lb-network"
+	albTargetGroupType = "lb-application-target-group"
+	nlbTargetGroupType = "lb-network-target-group"
+)
+
+func init() {
+	b.RegisterNamespace("AWS/ApplicationELB", ALBMetrics, CreateResourceList)
+	b.RegisterNamespace("AWS/NetworkELB", NLBMetrics, CreateResourceList)
+	b.RegisterLabels("AWS/ApplicationELB", "load_balancer", "availability_zone")
+	b.RegisterLabels("AWS/NetworkELB", "load_balancer", "availability_zone")
+}
+
+// loadBalancerID returns the value of the LoadBalancer dimension of a load balancer, e.g. app/my-lb/50dc6c495c0c9188
+func loadBalancerID(lbArn *string) string {
+	return strings.Split(*lbArn, "loadbalancer/")[1]
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, td *elbv2.TagDescription) (*b.ResourceDescription, error) {
+	lbID := loadBalancerID(td.ResourceArn)
 	lbTypeAndName := strings.Split(lbID, "/")
 	lbName := lbTypeAndName[1]
 
 	rd := b.ResourceDescription{}
 	switch {
 	case lbTypeAndName[0] == "net" && *nd.Namespace == "AWS/NetworkELB":
-		rd.Type = aws.String("lb-network")
+		rd.Type = aws.String(nlbType)
 	case lbTypeAndName[0] == "app" && *nd.Namespace == "AWS/ApplicationELB":
-		rd.Type = aws.String("lb-application")
+		rd.Type = aws.String(albType)
 	default:
 		return nil, errors.New("invalid lb type")
 	}
@@ -40,24 +60,80 @@ func createResourceDescription(nd *b.NamespaceDescription, tags *string, td *elb
 	rd.Name = &lbName
 	rd.Parent = nd
 	rd.Tags = tags
+	rd.Labels = map[string]string{
+		"load_balancer": lbName,
+	}
 
 	return &rd, nil
 }
 
-// CreateResourceList fetches a list of all ALB/NLB resources in the region
-func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
-	defer wg.Done()
-	log.Debug("Creating ALB/NLB resource list ...")
-	session := elbv2.New(nd.Parent.Session)
-	input := elbv2.DescribeLoadBalancersInput{}
-	result, err := session.DescribeLoadBalancers(&input)
-	h.LogIfError(err)
+func createTargetGroupResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, tg *elbv2.TargetGroup, lb *b.ResourceDescription) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	switch *lb.Type {
+	case nlbType:
+		rd.Type = aws.String(nlbTargetGroupType)
+	case albType:
+		rd.Type = aws.String(albTargetGroupType)
+	default:
+		return nil, errors.New("invalid lb type")
+	}
 
-	resourceList := []*string{}
-	for _, lb := range result.LoadBalancers {
-		resourceList = append(resourceList, lb.LoadBalancerArn)
+	// The TargetGroup dimension is the end of the ARN, e.g. targetgroup/my-targets/73e2d6bc24d8a067
+	tgID := h.GetLastStringElement(strings.Split(*tg.TargetGroupArn, ":"))
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("TargetGroup"),
+			Value: tgID,
+		},
+		{
+			Name:  aws.String("LoadBalancer"),
+			Value: aws.String(loadBalancerID(lb.ID)),
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+	rd.ID = tg.TargetGroupArn
+	rd.Name = tg.TargetGroupName
+	rd.Parent = nd
+	rd.Tags = tags
+	rd.Labels = map[string]string{
+		"load_balancer": *lb.Name,
+	}
+
+	return &rd, nil
+}
+
+// zonalResourceDescriptions returns a copy of rd per availability zone with the AvailabilityZone dimension added
+func zonalResourceDescriptions(rd *b.ResourceDescription, zones []*string) []*b.ResourceDescription {
+	resources := []*b.ResourceDescription{}
+	for _, zone := range zones {
+		dimensions := append([]*cloudwatch.Dimension{}, rd.Dimensions...)
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String("AvailabilityZone"),
+			Value: zone,
+		})
+		labels := map[string]string{}
+		for k, v := range rd.Labels {
+			labels[k] = v
+		}
+		labels["availability_zone"] = *zone
+
+		resources = append(resources, &b.ResourceDescription{
+			Name:       rd.Name,
+			ID:         rd.ID,
+			Dimensions: dimensions,
+			Type:       rd.Type,
+			Parent:     rd.Parent,
+			Tags:       rd.Tags,
+			Labels:     labels,
+		})
 	}
+	return resources
+}
 
+// describeTags fetches the tags of the input resources
+func describeTags(session *elbv2.ELBV2, resourceList []*string) []*elbv2.TagDescription {
 	// The AWS ELBV2 API has a limit of 20 resources which can be described in one request
 	chunkSize := 20
 	tagDescriptions := []*elbv2.TagDescription{}
@@ -72,21 +148,83 @@ func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 		}
 		tags, err := session.DescribeTags(&dti)
 		h.LogIfError(err)
-		tagDescriptions = append(tagDescriptions, tags.TagDescriptions...)
+		if err == nil {
+			tagDescriptions = append(tagDescriptions, tags.TagDescriptions...)
+		}
 	}
+	return tagDescriptions
+}
+
+// CreateResourceList fetches a list of all ALB/NLB resources and their target groups in the region
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating ALB/NLB resource list ...")
+	session := elbv2.New(nd.Parent.Session)
+	input := elbv2.DescribeLoadBalancersInput{}
+	resourceList := []*string{}
+	zones := map[string][]*string{}
+	err := session.DescribeLoadBalancersPages(&input, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+		for _, lb := range page.LoadBalancers {
+			resourceList = append(resourceList, lb.LoadBalancerArn)
+			for _, az := range lb.AvailabilityZones {
+				zones[*lb.LoadBalancerArn] = append(zones[*lb.LoadBalancerArn], az.ZoneName)
+			}
+		}
+		return true
+	})
+	h.LogIfError(err)
+
+	perZone := nd.Parent.Config != nil && nd.Parent.Config.ELBv2AvailabilityZones
 
 	resources := []*b.ResourceDescription{}
-	for _, td := range tagDescriptions {
+	loadBalancers := map[string]*b.ResourceDescription{}
+	for _, td := range describeTags(session, resourceList) {
 		tl, found := nd.Parent.TagsFound(td)
-		ts := b.TagsToString(tl)
 		if found {
-			if r, err := createResourceDescription(nd, ts, td); err == nil {
+			if r, err := createResourceDescription(nd, tl, td); err == nil {
 				resources = append(resources, r)
+				loadBalancers[*r.ID] = r
+				if perZone {
+					resources = append(resources, zonalResourceDescriptions(r, zones[*r.ID])...)
+				}
 			}
 			h.LogIfError(err)
-		} else {
+		}
+	}
+
+	tgInput := elbv2.DescribeTargetGroupsInput{}
+	targetGroups := map[string]*elbv2.TargetGroup{}
+	tgList := []*string{}
+	err = session.DescribeTargetGroupsPages(&tgInput, func(page *elbv2.DescribeTargetGroupsOutput, lastPage bool) bool {
+		for _, tg := range page.TargetGroups {
+			targetGroups[*tg.TargetGroupArn] = tg
+			tgList = append(tgList, tg.TargetGroupArn)
+		}
+		return true
+	})
+	h.LogIfError(err)
+
+	for _, td := range describeTags(session, tgList) {
+		tl, found := nd.Parent.TagsFound(td)
+		if !found {
 			continue
 		}
+		tg := targetGroups[*td.ResourceArn]
+		for _, lbArn := range tg.LoadBalancerArns {
+			// Load balancers of the other namespace or filtered out by tags
+			lb, ok := loadBalancers[*lbArn]
+			if !ok {
+				continue
+			}
+			r, err := createTargetGroupResourceDescription(nd, tl, tg, lb)
+			if err == nil {
+				resources = append(resources, r)
+				if perZone {
+					resources = append(resources, zonalResourceDescriptions(r, zones[*lbArn])...)
+				}
+			}
+			h.LogIfError(err)
+		}
 	}
 
 	nd.Mutex.Lock()
@@ -0,0 +1,142 @@
+package events
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+)
+
+// Metrics of rules on the default event bus are published without the EventBusName dimension
+const defaultEventBus = "default"
+
+func init() {
+	b.RegisterNamespace("AWS/Events", Metrics, CreateResourceList)
+	b.RegisterLabels("AWS/Events", "event_bus")
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, rule *eventbridge.Rule) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("RuleName"),
+			Value: rule.Name,
+		},
+	}
+	if *rule.EventBusName != defaultEventBus {
+		dd = append(dd, &b.DimensionDescription{
+			Name:  aws.String("EventBusName"),
+			Value: rule.EventBusName,
+		})
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = rule.Arn
+	rd.Name = rule.Name
+	rd.Type = aws.String("events-rule")
+	rd.Parent = nd
+	rd.Tags = tags
+	rd.Labels = map[string]string{
+		"event_bus": *rule.EventBusName,
+	}
+
+	return &rd, nil
+}
+
+// listEventBuses fetches the names of every event bus, following NextToken as there is no paginator for ListEventBuses
+func listEventBuses(session *eventbridge.EventBridge) ([]*string, error) {
+	names := []*string{}
+	input := eventbridge.ListEventBusesInput{}
+	for {
+		page, err := session.ListEventBuses(&input)
+		if err != nil {
+			return names, err
+		}
+		for _, bus := range page.EventBuses {
+			names = append(names, bus.Name)
+		}
+		if page.NextToken == nil {
+			return names, nil
+		}
+		input.NextToken = page.NextToken
+	}
+}
+
+// listRules fetches every rule of an event bus, following NextToken as there is no paginator for ListRules
+func listRules(session *eventbridge.EventBridge, busName *string) ([]*eventbridge.Rule, error) {
+	rules := []*eventbridge.Rule{}
+	input := eventbridge.ListRulesInput{
+		EventBusName: busName,
+	}
+	for {
+		page, err := session.ListRules(&input)
+		if err != nil {
+			return rules, err
+		}
+		for _, rule := range page.Rules {
+			if rule.EventBusName == nil {
+				rule.EventBusName = busName
+			}
+			rules = append(rules, rule)
+		}
+		if page.NextToken == nil {
+			return rules, nil
+		}
+		input.NextToken = page.NextToken
+	}
+}
+
+// CreateResourceList fetches a list of all EventBridge rules of every event bus in the region
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating EventBridge resource list ...")
+	session := eventbridge.New(nd.Parent.Session)
+	buses, err := listEventBuses(session)
+	h.LogIfError(err)
+
+	rules := []*eventbridge.Rule{}
+	for _, bus := range buses {
+		r, err := listRules(session, bus)
+		h.LogIfError(err)
+		rules = append(rules, r...)
+	}
+
+	var w sync.WaitGroup
+	w.Add(len(rules))
+	ch := make(chan *b.ResourceDescription, len(rules))
+	for _, rule := range rules {
+		go func(rule *eventbridge.Rule, wg *sync.WaitGroup) {
+			defer wg.Done()
+			input := eventbridge.ListTagsForResourceInput{
+				ResourceARN: rule.Arn,
+			}
+			tags, err := session.ListTagsForResource(&input)
+			h.LogIfError(err)
+
+			tl, found := nd.Parent.TagsFound(tags)
+
+			if found {
+				if r, err := createResourceDescription(nd, tl, rule); err == nil {
+					ch <- r
+				}
+				h.LogIfError(err)
+			}
+		}(rule, &w)
+	}
+	w.Wait()
+	close(ch)
+
+	resources := []*b.ResourceDescription{}
+	for r := range ch {
+		resources = append(resources, r)
+	}
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
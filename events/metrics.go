@@ -0,0 +1,36 @@
+package events
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"FailedInvocations": {
+		Help:       aws.String("The number of invocations of the rule's targets that failed permanently"),
+		OutputName: aws.String("events_failed_invocations"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"Invocations": {
+		Help:       aws.String("The number of times the rule's targets were invoked in response to an event"),
+		OutputName: aws.String("events_invocations"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"ThrottledRules": {
+		Help:       aws.String("The number of triggered rules that are being throttled"),
+		OutputName: aws.String("events_throttled_rules"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
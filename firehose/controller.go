@@ -0,0 +1,113 @@
+package firehose
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/firehose"
+)
+
+func init() {
+	b.RegisterNamespace("AWS/Firehose", Metrics, CreateResourceList)
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, streamName *string) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("DeliveryStreamName"),
+			Value: streamName,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = streamName
+	rd.Name = streamName
+	rd.Type = aws.String("firehose")
+	rd.Parent = nd
+	rd.Tags = tags
+
+	return &rd, nil
+}
+
+// listDeliveryStreams fetches the names of every delivery stream, following
+// ExclusiveStartDeliveryStreamName while HasMoreDeliveryStreams is set
+func listDeliveryStreams(session *firehose.Firehose) ([]*string, error) {
+	streamNames := []*string{}
+	input := firehose.ListDeliveryStreamsInput{}
+	for {
+		page, err := session.ListDeliveryStreams(&input)
+		if err != nil {
+			return streamNames, err
+		}
+		streamNames = append(streamNames, page.DeliveryStreamNames...)
+		if !aws.BoolValue(page.HasMoreDeliveryStreams) || len(page.DeliveryStreamNames) == 0 {
+			return streamNames, nil
+		}
+		input.ExclusiveStartDeliveryStreamName = page.DeliveryStreamNames[len(page.DeliveryStreamNames)-1]
+	}
+}
+
+// listTags fetches every tag of a delivery stream, following ExclusiveStartTagKey while HasMoreTags is set
+func listTags(session *firehose.Firehose, streamName *string) (*firehose.ListTagsForDeliveryStreamOutput, error) {
+	tags := &firehose.ListTagsForDeliveryStreamOutput{}
+	input := firehose.ListTagsForDeliveryStreamInput{
+		DeliveryStreamName: streamName,
+	}
+	for {
+		page, err := session.ListTagsForDeliveryStream(&input)
+		if err != nil {
+			return tags, err
+		}
+		tags.Tags = append(tags.Tags, page.Tags...)
+		if !aws.BoolValue(page.HasMoreTags) || len(page.Tags) == 0 {
+			return tags, nil
+		}
+		input.ExclusiveStartTagKey = page.Tags[len(page.Tags)-1].Key
+	}
+}
+
+// CreateResourceList fetches a list of all Firehose delivery streams in the region
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating Firehose resource list ...")
+	session := firehose.New(nd.Parent.Session)
+	streamNames, err := listDeliveryStreams(session)
+	h.LogIfError(err)
+
+	var w sync.WaitGroup
+	w.Add(len(streamNames))
+	ch := make(chan *b.ResourceDescription, len(streamNames))
+	for _, sn := range streamNames {
+		go func(sn *string, wg *sync.WaitGroup) {
+			defer wg.Done()
+			tags, err := listTags(session, sn)
+			h.LogIfError(err)
+
+			tl, found := nd.Parent.TagsFound(tags)
+
+			if found {
+				if r, err := createResourceDescription(nd, tl, sn); err == nil {
+					ch <- r
+				}
+				h.LogIfError(err)
+			}
+		}(sn, &w)
+	}
+	w.Wait()
+	close(ch)
+
+	resources := []*b.ResourceDescription{}
+	for r := range ch {
+		resources = append(resources, r)
+	}
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
@@ -0,0 +1,36 @@
+package firehose
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"DeliveryToS3.DataFreshness": {
+		Help:       aws.String("The age in seconds of the oldest record in the delivery stream which has not been delivered to Amazon S3"),
+		OutputName: aws.String("firehose_delivery_to_s3_data_freshness"),
+		Statistic:  h.StringPointers("Average", "Maximum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"DeliveryToS3.Success": {
+		Help:       aws.String("The ratio of successful Amazon S3 put commands to all Amazon S3 put commands"),
+		OutputName: aws.String("firehose_delivery_to_s3_success"),
+		Statistic:  h.StringPointers("Average", "Minimum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"IncomingRecords": {
+		Help:       aws.String("The number of records ingested into the delivery stream"),
+		OutputName: aws.String("firehose_incoming_records"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
@@ -26,6 +26,8 @@ var badSplits = map[string]string{
 	"s_3_":         "s3_",
 	"elasti_cache": "elasticache",
 	"_i_ds":        "_ids",
+	"cloud_front":  "cloudfront",
+	"route_53":     "route53",
 }
 
 // ToSnakeCase converts a CamelCaseString to snake_case
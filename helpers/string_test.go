@@ -0,0 +1,22 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToPromString(t *testing.T) {
+	tests := map[string]string{
+		"EC2_CPUUtilization":                         "ec2_cpu_utilization",
+		"ApplicationELB_UnHealthyHostCount":          "alb_unhealthy_host_count",
+		"Kinesis_GetRecords.IteratorAgeMilliseconds": "kinesis_get_records_iterator_age_milliseconds",
+		"Firehose_DeliveryToS3.DataFreshness":        "firehose_delivery_to_s3_data_freshness",
+		"Firehose_DeliveryToS3.Success":              "firehose_delivery_to_s3_success",
+		"CloudFront_Requests":                        "cloudfront_requests",
+		"Route53_HealthCheckStatus":                  "route53_health_check_status",
+	}
+	for input, expected := range tests {
+		assert.Equal(t, expected, ToPromString(input), input)
+	}
+}
@@ -1,7 +1,10 @@
 package helpers
 
 import (
+	"fmt"
+	"io/ioutil"
 	"log"
+	"path/filepath"
 
 	yaml "gopkg.in/yaml.v2"
 )
@@ -16,3 +19,21 @@ func YAMLDecode(path *string, i interface{}) {
 		log.Fatalf("File: %s does not exists!\n", *path)
 	}
 }
+
+// ReadYAML reads the file located at path and unmarshals it into the input interface
+//
+// Unlike YAMLDecode any error is returned rather than ending the process.
+func ReadYAML(path *string, i interface{}) error {
+	absolutePath, err := filepath.Abs(*path)
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadFile(absolutePath)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(content, i); err != nil {
+		return fmt.Errorf("error parsing %s: %s", *path, err)
+	}
+	return nil
+}
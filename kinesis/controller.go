@@ -0,0 +1,100 @@
+package kinesis
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+)
+
+func init() {
+	b.RegisterNamespace("AWS/Kinesis", Metrics, CreateResourceList)
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, streamName *string) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("StreamName"),
+			Value: streamName,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = streamName
+	rd.Name = streamName
+	rd.Type = aws.String("kinesis")
+	rd.Parent = nd
+	rd.Tags = tags
+
+	return &rd, nil
+}
+
+// listTags fetches every tag of a stream, following ExclusiveStartTagKey while HasMoreTags is set
+func listTags(session *kinesis.Kinesis, streamName *string) (*kinesis.ListTagsForStreamOutput, error) {
+	tags := &kinesis.ListTagsForStreamOutput{}
+	input := kinesis.ListTagsForStreamInput{
+		StreamName: streamName,
+	}
+	for {
+		page, err := session.ListTagsForStream(&input)
+		if err != nil {
+			return tags, err
+		}
+		tags.Tags = append(tags.Tags, page.Tags...)
+		if !aws.BoolValue(page.HasMoreTags) || len(page.Tags) == 0 {
+			return tags, nil
+		}
+		input.ExclusiveStartTagKey = page.Tags[len(page.Tags)-1].Key
+	}
+}
+
+// CreateResourceList fetches a list of all Kinesis data streams in the region
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating Kinesis resource list ...")
+	session := kinesis.New(nd.Parent.Session)
+	input := kinesis.ListStreamsInput{}
+	streamNames := []*string{}
+	err := session.ListStreamsPages(&input, func(page *kinesis.ListStreamsOutput, lastPage bool) bool {
+		streamNames = append(streamNames, page.StreamNames...)
+		return true
+	})
+	h.LogIfError(err)
+
+	var w sync.WaitGroup
+	w.Add(len(streamNames))
+	ch := make(chan *b.ResourceDescription, len(streamNames))
+	for _, sn := range streamNames {
+		go func(sn *string, wg *sync.WaitGroup) {
+			defer wg.Done()
+			tags, err := listTags(session, sn)
+			h.LogIfError(err)
+
+			tl, found := nd.Parent.TagsFound(tags)
+
+			if found {
+				if r, err := createResourceDescription(nd, tl, sn); err == nil {
+					ch <- r
+				}
+				h.LogIfError(err)
+			}
+		}(sn, &w)
+	}
+	w.Wait()
+	close(ch)
+
+	resources := []*b.ResourceDescription{}
+	for r := range ch {
+		resources = append(resources, r)
+	}
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
@@ -0,0 +1,44 @@
+package kinesis
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"GetRecords.IteratorAgeMilliseconds": {
+		Help:       aws.String("The age of the last record in all GetRecords calls made against a stream, a value of zero indicates that the records being read are completely caught up"),
+		OutputName: aws.String("kinesis_get_records_iterator_age_milliseconds"),
+		Statistic:  h.StringPointers("Average", "Maximum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"IncomingRecords": {
+		Help:       aws.String("The number of records successfully put to the stream"),
+		OutputName: aws.String("kinesis_incoming_records"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"ReadProvisionedThroughputExceeded": {
+		Help:       aws.String("The number of GetRecords calls throttled for the stream"),
+		OutputName: aws.String("kinesis_read_provisioned_throughput_exceeded"),
+		Statistic:  h.StringPointers("Average", "Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"WriteProvisionedThroughputExceeded": {
+		Help:       aws.String("The number of records rejected due to throttling for the stream"),
+		OutputName: aws.String("kinesis_write_provisioned_throughput_exceeded"),
+		Statistic:  h.StringPointers("Average", "Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
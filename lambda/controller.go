@@ -0,0 +1,84 @@
+package lambda
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+func init() {
+	b.RegisterNamespace("AWS/Lambda", Metrics, CreateResourceList)
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, fn *lambda.FunctionConfiguration) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("FunctionName"),
+			Value: fn.FunctionName,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = fn.FunctionArn
+	rd.Name = fn.FunctionName
+	rd.Type = aws.String("lambda")
+	rd.Parent = nd
+	rd.Tags = tags
+
+	return &rd, nil
+}
+
+// CreateResourceList fetches a list of all Lambda functions in the region
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating Lambda resource list ...")
+	session := lambda.New(nd.Parent.Session)
+	input := lambda.ListFunctionsInput{}
+	functions := []*lambda.FunctionConfiguration{}
+	err := session.ListFunctionsPages(&input, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		functions = append(functions, page.Functions...)
+		return true
+	})
+	h.LogIfError(err)
+
+	var w sync.WaitGroup
+	w.Add(len(functions))
+	ch := make(chan *b.ResourceDescription, len(functions))
+	for _, fn := range functions {
+		go func(fn *lambda.FunctionConfiguration, wg *sync.WaitGroup) {
+			defer wg.Done()
+			input := lambda.ListTagsInput{
+				Resource: fn.FunctionArn,
+			}
+			tags, err := session.ListTags(&input)
+			h.LogIfError(err)
+
+			tl, found := nd.Parent.TagsFound(tags)
+
+			if found {
+				if r, err := createResourceDescription(nd, tl, fn); err == nil {
+					ch <- r
+				}
+				h.LogIfError(err)
+			}
+		}(fn, &w)
+	}
+	w.Wait()
+	close(ch)
+
+	resources := []*b.ResourceDescription{}
+	for r := range ch {
+		resources = append(resources, r)
+	}
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
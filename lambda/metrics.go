@@ -0,0 +1,68 @@
+package lambda
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"ConcurrentExecutions": {
+		Help:       aws.String("The number of function instances that are processing events"),
+		OutputName: aws.String("lambda_concurrent_executions"),
+		Statistic:  h.StringPointers("Average", "Maximum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"DeadLetterErrors": {
+		Help:       aws.String("The number of times that Lambda attempts to send an event to a dead-letter queue but fails"),
+		OutputName: aws.String("lambda_dead_letter_errors"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"Duration": {
+		Help:       aws.String("The amount of time in milliseconds that the function code spends processing an event"),
+		OutputName: aws.String("lambda_duration"),
+		Statistic:  h.StringPointers("Average", "Maximum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"Errors": {
+		Help:       aws.String("The number of invocations that result in a function error"),
+		OutputName: aws.String("lambda_errors"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"Invocations": {
+		Help:       aws.String("The number of times that the function code is invoked, including successful invocations and invocations that result in a function error"),
+		OutputName: aws.String("lambda_invocations"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"IteratorAge": {
+		Help:       aws.String("The age in milliseconds of the last record in the event for event source mappings that read from streams"),
+		OutputName: aws.String("lambda_iterator_age"),
+		Statistic:  h.StringPointers("Average", "Maximum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"Throttles": {
+		Help:       aws.String("The number of invocation requests that are throttled"),
+		OutputName: aws.String("lambda_throttles"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
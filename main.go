@@ -1,33 +1,51 @@
 package main
 
 import (
+	"errors"
 	"flag"
+	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
 	"sync"
+	"syscall"
 	"time"
 
-	"github.com/CoverGenius/cloudwatch-prometheus-exporter/backup"
-	"github.com/CoverGenius/cloudwatch-prometheus-exporter/ec2"
-	"github.com/CoverGenius/cloudwatch-prometheus-exporter/elasticache"
-	"github.com/CoverGenius/cloudwatch-prometheus-exporter/elb"
-	"github.com/CoverGenius/cloudwatch-prometheus-exporter/elbv2"
-	"github.com/CoverGenius/cloudwatch-prometheus-exporter/network"
-	"github.com/CoverGenius/cloudwatch-prometheus-exporter/s3"
-	"github.com/CoverGenius/cloudwatch-prometheus-exporter/sqs"
-	"github.com/CoverGenius/cloudwatch-prometheus-exporter/vpc"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/acm"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/alarms"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/apigateway"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/backup"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/billing"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/cloudfront"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/dynamodb"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/ebs"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/ec2"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/ecs"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/elasticache"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/elb"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/elbv2"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/events"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/firehose"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/kinesis"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/lambda"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/network"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/quotas"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/rds"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/route53"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/s3"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/sns"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/sqs"
+	_ "github.com/CoverGenius/cloudwatch-prometheus-exporter/vpc"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
 	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
-	"github.com/CoverGenius/cloudwatch-prometheus-exporter/rds"
 	log "github.com/sirupsen/logrus"
 )
 
 var (
-	rdd         []*base.RegionDescription
 	aws_session *session.Session
 	config      string
 )
@@ -36,35 +54,33 @@ func init() {
 	flag.StringVar(&config, "config", "config.yaml", "Path to config file")
 }
 
-func run(nd map[string]*base.NamespaceDescription, cw *cloudwatch.CloudWatch, rd *base.RegionDescription, pi int64) {
+func run(nd map[string]*base.NamespaceDescription, cw *cloudwatch.CloudWatch, rd *base.RegionDescription, pi int64, stop <-chan struct{}) {
 	var delay int64 = 0
 	for {
 		select {
+		case <-stop:
+			log.Infof("Stopped polling region %s", *rd.Region)
+			return
 		case <-time.After(time.Duration(delay) * time.Second):
 			var wg sync.WaitGroup
-			wg.Add(11)
+			wg.Add(len(nd))
 			log.Debug("Creating list of resources ...")
-			go elasticache.CreateResourceList(nd["AWS/ElastiCache"], &wg)
-			go rds.CreateResourceList(nd["AWS/RDS"], &wg)
-			go ec2.CreateResourceList(nd["AWS/EC2"], &wg)
-			go network.CreateResourceList(nd["AWS/NATGateway"], &wg)
-			go elb.CreateResourceList(nd["AWS/ELB"], &wg)
-			go elbv2.CreateResourceList(nd["AWS/ApplicationELB"], &wg)
-			go elbv2.CreateResourceList(nd["AWS/NetworkELB"], &wg)
-			go s3.CreateResourceList(nd["AWS/S3"], &wg)
-			go sqs.CreateResourceList(nd["AWS/SQS"], &wg)
-			go vpc.CreateResourceList(nd["AWS/VPC"], &wg)
-			go backup.CreateResourceList(nd["AWS/Backup"], &wg)
+			for _, namespace := range nd {
+				go namespace.CreateResourceList(&wg)
+			}
 			wg.Wait()
+			rd.MarkDiscovered()
 			delay = pi
 			go rd.GatherMetrics(cw)
 		}
 	}
 }
 
-func processConfig(p *string) *base.Config {
+func processConfig(p *string) (*base.Config, error) {
 	c := base.Config{}
-	h.YAMLDecode(p, &c)
+	if err := h.ReadYAML(p, &c); err != nil {
+		return nil, err
+	}
 
 	if c.Listen == "" {
 		c.Listen = "127.0.0.1:8080"
@@ -78,64 +94,66 @@ func processConfig(p *string) *base.Config {
 		c.RangeSeconds = 300
 	}
 
-	if c.AccountID == "" {
-		log.Fatal("Please specify account ID")
+	if len(c.Accounts) == 0 && c.AccountID == "" {
+		return nil, errors.New("please specify account ID")
 	}
 
-	if c.APIKey != "" && c.APISecret != "" {
-		log.Info("Using access key ID and secret access key in order to establish a session!")
-		os.Setenv("AWS_ACCESS_KEY_ID", c.APIKey)
-		os.Setenv("AWS_SECRET_ACCESS_KEY", c.APISecret)
-	} else {
-		log.Info("api_key or api_secret is empty. Trying EC2 role instead!")
+	for _, a := range c.GetAccounts() {
+		if a.AccountID == "" {
+			return nil, errors.New("please specify account ID for every account")
+		}
+		if len(a.Regions) < 1 {
+			return nil, fmt.Errorf("no regions specified for account %s, please set at least one", a.AccountID)
+		}
 	}
 
-	if len(c.Regions) < 1 {
-		log.Fatal("No regions specified. Please set at least one!")
+	for _, q := range c.Quotas {
+		if q.ServiceCode == "" || q.QuotaCode == "" {
+			return nil, errors.New("please specify service_code and quota_code for every quota")
+		}
 	}
 
 	if c.PollInterval == 0 {
 		c.PollInterval = 300
 	}
 
+	if c.ReadinessMultiplier == 0 {
+		c.ReadinessMultiplier = 3
+	}
+
+	if c.APIKey != "" && c.APISecret != "" {
+		log.Info("Using access key ID and secret access key in order to establish a session!")
+	} else if c.Profile != "" {
+		log.Infof("api_key or api_secret is empty. Using profile %s instead!", c.Profile)
+	} else {
+		log.Info("api_key or api_secret is empty. Using the default credential chain instead!")
+	}
+
 	log.SetOutput(os.Stdout)
 	log.SetLevel(h.GetLogLevel(c.LogLevel))
 
-	return &c
+	return &c, nil
 }
 
 func main() {
 	flag.Parse()
-	// TODO allow hot reload of config
-	c := processConfig(&config)
-
-	defaults := map[string]map[string]*base.MetricDescription{
-		"AWS/RDS":            rds.Metrics,
-		"AWS/ElastiCache":    elasticache.Metrics,
-		"AWS/EC2":            ec2.Metrics,
-		"AWS/NATGateway":     network.Metrics,
-		"AWS/ELB":            elb.Metrics,
-		"AWS/ApplicationELB": elbv2.ALBMetrics,
-		"AWS/NetworkELB":     elbv2.NLBMetrics,
-		"AWS/S3":             s3.Metrics,
-		"AWS/SQS":            sqs.Metrics,
-		"AWS/VPC":            vpc.Metrics,
-		"AWS/Backup":         backup.Metrics,
+	c, err := processConfig(&config)
+	if err != nil {
+		log.Fatalf("error loading config: %s", err)
 	}
-	mds := c.ConstructMetrics(defaults)
-
-	for _, r := range c.Regions {
-		awsSession := base.CreateAWSSession(c, r)
-		cw := cloudwatch.New(awsSession)
-		rd := base.RegionDescription{Region: r}
-		rdd = append(rdd, &rd)
-		if err := rd.Init(awsSession, &c.AccountID, c.Tags, mds); err != nil {
-			log.Fatalf("error initializing region: %s", err)
-		}
+	applyConfig(c)
 
-		go run(rd.Namespaces, cw, &rd, c.PollInterval)
-	}
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			h.LogIfError(reload())
+		}
+	}()
 
 	http.Handle("/metrics", promhttp.Handler())
+	http.HandleFunc("/-/reload", reloadHandler)
+	http.HandleFunc("/-/healthy", healthyHandler)
+	http.HandleFunc("/-/ready", readyHandler)
 	log.Fatal(http.ListenAndServe(c.Listen, nil))
 }
@@ -10,6 +10,10 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+func init() {
+	b.RegisterNamespace("AWS/NATGateway", Metrics, CreateResourceList)
+}
+
 func createResourceDescription(nd *b.NamespaceDescription, ng *ec2.NatGateway) (*b.ResourceDescription, error) {
 	rd := b.ResourceDescription{}
 	dd := []*b.DimensionDescription{
@@ -26,7 +30,6 @@ func createResourceDescription(nd *b.NamespaceDescription, ng *ec2.NatGateway) (
 	rd.Name = ng.NatGatewayId
 	rd.Type = aws.String("nat-gateway")
 	rd.Parent = nd
-	rd.Tags = aws.String("")
 
 	return &rd, nil
 }
@@ -39,16 +42,18 @@ func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 	input := ec2.DescribeNatGatewaysInput{
 		Filter: nd.Parent.Filters,
 	}
-	result, err := session.DescribeNatGateways(&input)
-	h.LogIfError(err)
-
 	resources := []*b.ResourceDescription{}
-	for _, ng := range result.NatGateways {
-		if r, err := createResourceDescription(nd, ng); err == nil {
-			resources = append(resources, r)
+	err := session.DescribeNatGatewaysPages(&input, func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
+		for _, ng := range page.NatGateways {
+			r, err := createResourceDescription(nd, ng)
+			if err == nil {
+				resources = append(resources, r)
+			}
+			h.LogIfError(err)
 		}
-		h.LogIfError(err)
-	}
+		return true
+	})
+	h.LogIfError(err)
 	nd.Mutex.Lock()
 	nd.Resources = resources
 	nd.Mutex.Unlock()
@@ -0,0 +1,144 @@
+package quotas
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+)
+
+// quotaData is what the last discovery found about a quota. Usage is nil if
+// the quota has no usage metric or the metric had no datapoint.
+type quotaData struct {
+	quota     *servicequotas.ServiceQuota
+	usage     *float64
+	timestamp *time.Time // Time of the usage datapoint
+}
+
+// quotas holds the quotaData of every resource keyed by quota ARN, the gather
+// functions read the limit and usage from it
+var quotas = b.NewResourceCache()
+
+func init() {
+	b.RegisterNamespace("AWS/Usage", Metrics, CreateResourceList)
+	b.RegisterLabels("AWS/Usage", "service_code", "quota_code")
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, quota *servicequotas.ServiceQuota) (*b.ResourceDescription, error) {
+	if quota.QuotaArn == nil {
+		return nil, fmt.Errorf("missing ARN of quota %s of %s", aws.StringValue(quota.QuotaCode), aws.StringValue(quota.ServiceCode))
+	}
+
+	rd := b.ResourceDescription{}
+	if err := rd.BuildDimensions([]*b.DimensionDescription{}); err != nil {
+		return nil, err
+	}
+
+	rd.ID = quota.QuotaArn
+	rd.Name = quota.QuotaName
+	rd.Type = aws.String("quota")
+	rd.Parent = nd
+	rd.Labels = map[string]string{
+		"service_code": aws.StringValue(quota.ServiceCode),
+		"quota_code":   aws.StringValue(quota.QuotaCode),
+	}
+
+	return &rd, nil
+}
+
+// listServiceQuotas returns the quotas of a service which are in codes
+func listServiceQuotas(session *servicequotas.ServiceQuotas, service string, codes map[string]bool) ([]*servicequotas.ServiceQuota, error) {
+	input := servicequotas.ListServiceQuotasInput{
+		ServiceCode: aws.String(service),
+	}
+	result := []*servicequotas.ServiceQuota{}
+	err := session.ListServiceQuotasPages(&input, func(page *servicequotas.ListServiceQuotasOutput, lastPage bool) bool {
+		for _, quota := range page.Quotas {
+			if codes[aws.StringValue(quota.QuotaCode)] {
+				result = append(result, quota)
+			}
+		}
+		return true
+	})
+
+	return result, err
+}
+
+// CreateResourceList fetches the quotas configured to be tracked in the region
+// and the latest value of their usage metrics, so the usage is fetched once per
+// poll for both the usage and the utilization. Quotas which were never adjusted
+// are not always listed with an applied value, the AWS default value is used
+// for those.
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating quota resource list ...")
+
+	services := map[string]map[string]bool{}
+	if nd.Parent.Config != nil {
+		for _, q := range nd.Parent.Config.Quotas {
+			if services[q.ServiceCode] == nil {
+				services[q.ServiceCode] = map[string]bool{}
+			}
+			services[q.ServiceCode][q.QuotaCode] = true
+		}
+	}
+
+	session := servicequotas.New(nd.Parent.Session)
+	found := []*servicequotas.ServiceQuota{}
+	for service, codes := range services {
+		applied, err := listServiceQuotas(session, service, codes)
+		h.LogIfError(err)
+		for _, quota := range applied {
+			delete(codes, *quota.QuotaCode)
+		}
+		found = append(found, applied...)
+
+		for code := range codes {
+			input := servicequotas.GetAWSDefaultServiceQuotaInput{
+				ServiceCode: aws.String(service),
+				QuotaCode:   aws.String(code),
+			}
+			output, err := session.GetAWSDefaultServiceQuota(&input)
+			h.LogIfError(err)
+			if err == nil {
+				found = append(found, output.Quota)
+			}
+		}
+	}
+
+	resources := []*b.ResourceDescription{}
+	data := map[string]*quotaData{}
+	for _, quota := range found {
+		r, err := createResourceDescription(nd, quota)
+		h.LogIfError(err)
+		if err == nil {
+			resources = append(resources, r)
+			data[*quota.QuotaArn] = &quotaData{quota: quota}
+		}
+	}
+
+	rangeSeconds := int64(300)
+	if nd.Parent.Config != nil && nd.Parent.Config.RangeSeconds > 0 {
+		rangeSeconds = nd.Parent.Config.RangeSeconds
+	}
+	end := time.Now()
+	start := end.Add(-time.Duration(rangeSeconds) * time.Second)
+	h.LogIfError(fetchUsage(cloudwatch.New(nd.Parent.Session), data, start, end))
+
+	cached := map[string]interface{}{}
+	for arn, d := range data {
+		cached[arn] = d
+	}
+	quotas.Replace(nd.Parent, cached)
+
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
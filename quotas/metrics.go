@@ -0,0 +1,172 @@
+package quotas
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+)
+
+func findQuota(rd *b.ResourceDescription) (*quotaData, bool) {
+	if v, ok := quotas.Get(rd); ok {
+		return v.(*quotaData), true
+	}
+	return nil, false
+}
+
+// usageQuery builds the query for the usage metric of a quota
+func usageQuery(id string, usage *servicequotas.MetricInfo) *cloudwatch.MetricDataQuery {
+	names := make([]string, 0, len(usage.MetricDimensions))
+	for name := range usage.MetricDimensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	dimensions := make([]*cloudwatch.Dimension, len(names))
+	for idx, name := range names {
+		dimensions[idx] = &cloudwatch.Dimension{
+			Name:  aws.String(name),
+			Value: usage.MetricDimensions[name],
+		}
+	}
+
+	stat := "Maximum"
+	if usage.MetricStatisticRecommendation != nil {
+		stat = *usage.MetricStatisticRecommendation
+	}
+
+	return &cloudwatch.MetricDataQuery{
+		Id: aws.String(id),
+		MetricStat: &cloudwatch.MetricStat{
+			Metric: &cloudwatch.Metric{
+				Namespace:  usage.MetricNamespace,
+				MetricName: usage.MetricName,
+				Dimensions: dimensions,
+			},
+			Period: aws.Int64(60),
+			Stat:   aws.String(stat),
+		},
+	}
+}
+
+// fetchUsage sets the latest value of the usage metric of every quota which has one
+func fetchUsage(cw cloudwatchiface.CloudWatchAPI, data map[string]*quotaData, start time.Time, end time.Time) error {
+	queries := []*cloudwatch.MetricDataQuery{}
+	targets := map[string]*quotaData{}
+	for _, d := range data {
+		if d.quota.UsageMetric == nil || d.quota.UsageMetric.MetricName == nil {
+			continue
+		}
+		id := fmt.Sprintf("q%d", len(queries))
+		queries = append(queries, usageQuery(id, d.quota.UsageMetric))
+		targets[id] = d
+	}
+
+	results, err := b.GetMetricData(cw, queries, start, end)
+	for _, result := range results {
+		d, ok := targets[aws.StringValue(result.Id)]
+		if !ok || len(result.Values) < 1 || len(result.Timestamps) < 1 {
+			continue
+		}
+		// AWS returns the data in descending order
+		d.usage = result.Values[0]
+		d.timestamp = result.Timestamps[0]
+	}
+
+	return err
+}
+
+func gatherLimitFunc(rds []*b.ResourceDescription, start time.Time, end time.Time) ([]*b.NonCloudWatchMetric, error) {
+	result := []*b.NonCloudWatchMetric{}
+	for _, rd := range rds {
+		d, ok := findQuota(rd)
+		if !ok || d.quota.Value == nil {
+			continue
+		}
+		metric := b.NonCloudWatchMetric{
+			Timestamps: []*time.Time{aws.Time(time.Now())},
+			Statistic:  "Average",
+			Resource:   rd,
+			Values:     []*float64{d.quota.Value},
+		}
+		result = append(result, &metric)
+	}
+
+	return result, nil
+}
+
+func gatherUsageFunc(rds []*b.ResourceDescription, start time.Time, end time.Time) ([]*b.NonCloudWatchMetric, error) {
+	result := []*b.NonCloudWatchMetric{}
+	for _, rd := range rds {
+		d, ok := findQuota(rd)
+		if !ok || d.usage == nil {
+			continue
+		}
+		metric := b.NonCloudWatchMetric{
+			Timestamps: []*time.Time{d.timestamp},
+			Statistic:  "Average",
+			Resource:   rd,
+			Values:     []*float64{d.usage},
+		}
+		result = append(result, &metric)
+	}
+
+	return result, nil
+}
+
+func gatherUtilizationFunc(rds []*b.ResourceDescription, start time.Time, end time.Time) ([]*b.NonCloudWatchMetric, error) {
+	result := []*b.NonCloudWatchMetric{}
+	for _, rd := range rds {
+		d, ok := findQuota(rd)
+		if !ok || d.usage == nil || aws.Float64Value(d.quota.Value) <= 0 {
+			continue
+		}
+		metric := b.NonCloudWatchMetric{
+			Timestamps: []*time.Time{d.timestamp},
+			Statistic:  "Average",
+			Resource:   rd,
+			Values:     []*float64{aws.Float64(*d.usage / *d.quota.Value)},
+		}
+		result = append(result, &metric)
+	}
+
+	return result, nil
+}
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"QuotaLimit": {
+		Help:       aws.String("The applied value of the service quota"),
+		OutputName: aws.String("aws_quota_limit"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.NON_CLOUDWATCH_KIND),
+		GatherFunc: gatherLimitFunc,
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"QuotaUsage": {
+		Help:       aws.String("The latest usage of the service quota as reported by its CloudWatch usage metric"),
+		OutputName: aws.String("aws_quota_usage"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.NON_CLOUDWATCH_KIND),
+		GatherFunc: gatherUsageFunc,
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"QuotaUtilization": {
+		Help:       aws.String("The ratio of the usage to the applied value of the service quota"),
+		OutputName: aws.String("aws_quota_utilization_ratio"),
+		Statistic:  h.StringPointers("Average"),
+		Kind:       aws.String(b.NON_CLOUDWATCH_KIND),
+		GatherFunc: gatherUtilizationFunc,
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
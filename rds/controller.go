@@ -5,13 +5,18 @@ import (
 	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
 	log "github.com/sirupsen/logrus"
 
+	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 )
 
-func createResourceDescription(nd *b.NamespaceDescription, tags *string, dbi *rds.DBInstance) (*b.ResourceDescription, error) {
+func init() {
+	b.RegisterNamespace("AWS/RDS", Metrics, CreateResourceList)
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, dbi *rds.DBInstance) (*b.ResourceDescription, error) {
 	rd := b.ResourceDescription{}
 	dd := []*b.DimensionDescription{
 		{
@@ -32,19 +37,65 @@ func createResourceDescription(nd *b.NamespaceDescription, tags *string, dbi *rd
 	return &rd, nil
 }
 
-// CreateResourceList fetches a list of all RDS databases in the region
+func createClusterResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, dbc *rds.DBCluster) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("DBClusterIdentifier"),
+			Value: dbc.DBClusterIdentifier,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = dbc.DBClusterIdentifier
+	rd.Name = dbc.DBClusterIdentifier
+	rd.Type = aws.String("rds-cluster")
+	rd.Parent = nd
+	rd.Tags = tags
+
+	return &rd, nil
+}
+
+// isRDSCluster reports whether a cluster publishes its metrics to AWS/RDS.
+//
+// DescribeDBClusters also returns Neptune and DocumentDB clusters, which
+// publish to their own namespaces.
+func isRDSCluster(dbc *rds.DBCluster) bool {
+	engine := aws.StringValue(dbc.Engine)
+	return strings.HasPrefix(engine, "aurora") || engine == "mysql" || engine == "postgres"
+}
+
+// CreateResourceList fetches a list of all RDS databases and DB clusters in the region
 func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 	defer wg.Done()
 	log.Debug("Creating RDS resource list ...")
 	session := rds.New(nd.Parent.Session)
 	input := rds.DescribeDBInstancesInput{}
-	result, err := session.DescribeDBInstances(&input)
+	instances := []*rds.DBInstance{}
+	err := session.DescribeDBInstancesPages(&input, func(page *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		instances = append(instances, page.DBInstances...)
+		return true
+	})
+	h.LogIfError(err)
+
+	clusterInput := rds.DescribeDBClustersInput{}
+	clusters := []*rds.DBCluster{}
+	err = session.DescribeDBClustersPages(&clusterInput, func(page *rds.DescribeDBClustersOutput, lastPage bool) bool {
+		for _, dbc := range page.DBClusters {
+			if isRDSCluster(dbc) {
+				clusters = append(clusters, dbc)
+			}
+		}
+		return true
+	})
 	h.LogIfError(err)
 
 	var w sync.WaitGroup
-	w.Add(len(result.DBInstances))
-	ch := make(chan *b.ResourceDescription, len(result.DBInstances))
-	for _, dbi := range result.DBInstances {
+	w.Add(len(instances) + len(clusters))
+	ch := make(chan *b.ResourceDescription, len(instances)+len(clusters))
+	for _, dbi := range instances {
 		go func(dbi *rds.DBInstance, wg *sync.WaitGroup) {
 			defer wg.Done()
 			input := rds.ListTagsForResourceInput{
@@ -54,16 +105,34 @@ func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 			h.LogIfError(err)
 
 			tl, found := nd.Parent.TagsFound(tags)
-			ts := b.TagsToString(tl)
 
 			if found {
-				if r, err := createResourceDescription(nd, ts, dbi); err == nil {
+				if r, err := createResourceDescription(nd, tl, dbi); err == nil {
 					ch <- r
 				}
 				h.LogIfError(err)
 			}
 		}(dbi, &w)
 	}
+	for _, dbc := range clusters {
+		go func(dbc *rds.DBCluster, wg *sync.WaitGroup) {
+			defer wg.Done()
+			input := rds.ListTagsForResourceInput{
+				ResourceName: dbc.DBClusterArn,
+			}
+			tags, err := session.ListTagsForResource(&input)
+			h.LogIfError(err)
+
+			tl, found := nd.Parent.TagsFound(tags)
+
+			if found {
+				if r, err := createClusterResourceDescription(nd, tl, dbc); err == nil {
+					ch <- r
+				}
+				h.LogIfError(err)
+			}
+		}(dbc, &w)
+	}
 	w.Wait()
 	close(ch)
 
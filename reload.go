@@ -0,0 +1,177 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// poller is a region of an account which is being polled for metrics
+type poller struct {
+	rd        *base.RegionDescription
+	signature string
+	stop      chan struct{}
+}
+
+var (
+	pollers     = map[string]*poller{}
+	current     *base.Config
+	reloadMutex sync.Mutex
+)
+
+// signature summarises the settings of a region of an account which can not be
+// changed while it is running. A region whose signature changes on reload is restarted.
+//
+// Every setting except the metrics, the log level and the listen address is
+// taken into account.
+func signature(c *base.Config, a *base.AccountConfig) string {
+	settings := *c
+	settings.Metrics = base.Config{}.Metrics
+	settings.LogLevel = 0
+	settings.Listen = ""
+	// Account membership is handled by applyConfig, only this account matters
+	settings.AccountID = ""
+	settings.Accounts = nil
+	settings.Regions = nil
+	settings.Tags = nil
+	// Region membership is handled by applyConfig, adding a region must not
+	// restart the other regions of the account
+	account := *a
+	account.Regions = nil
+
+	s, err := yaml.Marshal(struct {
+		Settings base.Config
+		Account  base.AccountConfig
+	}{settings, account})
+	if err != nil {
+		log.Errorf("error computing signature of account %s: %s", a.AccountID, err)
+	}
+	return string(s)
+}
+
+// applyConfig starts, stops or updates the polled regions of every account to match the input config
+func applyConfig(c *base.Config) {
+	mds := c.ConstructMetrics()
+
+	configured := map[string]bool{}
+	for _, a := range c.GetAccounts() {
+		sig := signature(c, a)
+		regions := a.Regions
+		if base.HasGlobalNamespaces(mds) {
+			regions = append(regions[:len(regions):len(regions)], aws.String(base.GlobalRegion))
+		}
+		for _, r := range regions {
+			rd := &base.RegionDescription{Region: r, AccountID: aws.String(a.AccountID), Config: c}
+			key := rd.Key()
+			configured[key] = true
+
+			if p, ok := pollers[key]; ok {
+				if p.signature == sig {
+					log.Infof("Updating metrics of region %s ...", key)
+					p.rd.UpdateMetrics(mds)
+					continue
+				}
+				stopPoller(key)
+			}
+
+			awsSession := base.CreateAWSSession(c, a, r)
+			cw := cloudwatch.New(awsSession)
+			if err := rd.Init(awsSession, &a.AccountID, a.Tags, mds); err != nil {
+				log.Errorf("error initializing region %s: %s", key, err)
+				continue
+			}
+
+			p := &poller{rd: rd, signature: sig, stop: make(chan struct{})}
+			pollers[key] = p
+			go run(rd.Namespaces, cw, rd, c.PollInterval, p.stop)
+		}
+	}
+
+	for key := range pollers {
+		if !configured[key] {
+			stopPoller(key)
+		}
+	}
+
+	if current != nil && current.Listen != c.Listen {
+		log.Warnf("listen address changed from %s to %s, a restart is required to apply it", current.Listen, c.Listen)
+		c.Listen = current.Listen
+	}
+	current = c
+}
+
+// stopPoller stops polling a region and drops all of its series
+func stopPoller(key string) {
+	log.Infof("Stopping region %s ...", key)
+	p := pollers[key]
+	close(p.stop)
+	p.rd.Stop()
+	base.RemoveRegion(p.rd)
+	delete(pollers, key)
+}
+
+// reload reads the config file again and applies it.
+//
+// If the new config is invalid it is rejected and the running config is kept.
+func reload() error {
+	reloadMutex.Lock()
+	defer reloadMutex.Unlock()
+
+	log.Info("Reloading config ...")
+	c, err := processConfig(&config)
+	if err != nil {
+		return fmt.Errorf("error reloading config, keeping the running config: %s", err)
+	}
+	applyConfig(c)
+	log.Info("Config reloaded")
+	return nil
+}
+
+func reloadHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "This endpoint requires a POST request", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := reload(); err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	fmt.Fprintln(w, "Config reloaded")
+}
+
+func healthyHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintln(w, "Healthy")
+}
+
+// readyHandler reports the exporter as ready once every configured region
+// completed a resource discovery and a gather, for as long as each of them
+// keeps being polled successfully.
+func readyHandler(w http.ResponseWriter, r *http.Request) {
+	reloadMutex.Lock()
+	maxAge := time.Duration(current.PollInterval*current.ReadinessMultiplier) * time.Second
+	errs := []string{}
+	for _, p := range pollers {
+		if err := p.rd.Ready(maxAge); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	reloadMutex.Unlock()
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		http.Error(w, strings.Join(errs, "\n"), http.StatusServiceUnavailable)
+		return
+	}
+	fmt.Fprintln(w, "Ready")
+}
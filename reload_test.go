@@ -0,0 +1,202 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+// testNamespace is polled by the reload tests, its single resource is
+// discovered without calling AWS
+const testNamespace = "Test/Reload"
+
+func init() {
+	base.RegisterNamespace(testNamespace, map[string]*base.MetricDescription{}, func(nd *base.NamespaceDescription, wg *sync.WaitGroup) {
+		defer wg.Done()
+		rd := base.ResourceDescription{}
+		rd.BuildDimensions([]*base.DimensionDescription{{Name: aws.String("QueueName"), Value: aws.String("queue")}})
+		rd.ID = aws.String("queue")
+		rd.Name = aws.String("queue")
+		rd.Type = aws.String("test")
+		rd.Parent = nd
+
+		nd.Mutex.Lock()
+		nd.Resources = []*base.ResourceDescription{&rd}
+		nd.Mutex.Unlock()
+	})
+}
+
+// monitoringServer answers GetMetricData calls with a datapoint per query.
+// Calls signed for the blocked region wait until release is closed.
+type monitoringServer struct {
+	*httptest.Server
+
+	blocked string
+	started chan struct{}
+	release chan struct{}
+}
+
+func newMonitoringServer(blocked string) *monitoringServer {
+	s := &monitoringServer{blocked: blocked, started: make(chan struct{}, 1), release: make(chan struct{})}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.getMetricData))
+	return s
+}
+
+func (s *monitoringServer) getMetricData(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if s.blocked != "" && strings.Contains(r.Header.Get("Authorization"), "/"+s.blocked+"/") {
+		select {
+		case s.started <- struct{}{}:
+		default:
+		}
+		<-s.release
+	}
+
+	results := ""
+	for i := 1; r.Form.Get(fmt.Sprintf("MetricDataQueries.member.%d.Id", i)) != ""; i++ {
+		results += fmt.Sprintf(
+			"<member><Id>%s</Id><StatusCode>Complete</StatusCode><Timestamps><member>%s</member></Timestamps><Values><member>1</member></Values></member>",
+			r.Form.Get(fmt.Sprintf("MetricDataQueries.member.%d.Id", i)),
+			time.Now().UTC().Format(time.RFC3339),
+		)
+	}
+	fmt.Fprintf(w, `<GetMetricDataResponse xmlns="http://monitoring.amazonaws.com/doc/2010-08-01/">
+<GetMetricDataResult><MetricDataResults>%s</MetricDataResults></GetMetricDataResult>
+<ResponseMetadata><RequestId>test</RequestId></ResponseMetadata>
+</GetMetricDataResponse>`, results)
+}
+
+// loadConfig processes a config which polls testNamespace through the monitoring server
+func loadConfig(t *testing.T, url string, region string, metric string) *base.Config {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := fmt.Sprintf(`account_id: "123456789012"
+api_key: test
+api_secret: test
+regions: [%s]
+poll_interval: 1
+endpoint_url:
+  monitoring: %s
+metrics:
+  %s:
+%s`, region, url, testNamespace, metric)
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+	c, err := processConfig(&path)
+	assert.NoError(t, err)
+	return c
+}
+
+// stopPollers stops every region started by a test
+func stopPollers() {
+	for key := range pollers {
+		stopPoller(key)
+	}
+	current = nil
+}
+
+// series returns the labels of every series exported for a metric
+func series(t *testing.T, name string) []map[string]string {
+	families, err := prometheus.DefaultGatherer.Gather()
+	assert.NoError(t, err)
+
+	result := []map[string]string{}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, m := range family.Metric {
+			labels := map[string]string{}
+			for _, l := range m.Label {
+				labels[l.GetName()] = l.GetValue()
+			}
+			result = append(result, labels)
+		}
+	}
+	return result
+}
+
+func TestReloadDuringGather(t *testing.T) {
+	server := newMonitoringServer("eu-west-1")
+	defer server.Close()
+	defer stopPollers()
+	metric := `    - metric: NumberOfMessagesSent
+      output_name: test_reload_during_gather`
+
+	applyConfig(loadConfig(t, server.URL, "eu-west-1", metric))
+	old := pollers["123456789012/eu-west-1"].rd
+	<-server.started
+
+	// the region is removed while its gather waits for GetMetricData
+	applyConfig(loadConfig(t, server.URL, "us-west-2", metric))
+	close(server.release)
+
+	assert.Eventually(t, func() bool {
+		err := old.Ready(time.Hour)
+		return err == nil || !strings.Contains(err.Error(), "gather")
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return len(series(t, "test_reload_during_gather")) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for _, labels := range series(t, "test_reload_during_gather") {
+		assert.Equal(t, "us-west-2", labels["region"])
+	}
+}
+
+func TestReloadAddRegion(t *testing.T) {
+	server := newMonitoringServer("")
+	defer server.Close()
+	defer stopPollers()
+	metric := `    - metric: NumberOfMessagesSent
+      output_name: test_reload_add_region`
+
+	applyConfig(loadConfig(t, server.URL, "eu-west-1", metric))
+	old := pollers["123456789012/eu-west-1"]
+
+	applyConfig(loadConfig(t, server.URL, "eu-west-1, us-west-2", metric))
+	assert.Same(t, old, pollers["123456789012/eu-west-1"])
+	assert.Contains(t, pollers, "123456789012/us-west-2")
+}
+
+func TestReloadSplitBy(t *testing.T) {
+	server := newMonitoringServer("")
+	defer server.Close()
+	defer stopPollers()
+
+	applyConfig(loadConfig(t, server.URL, "eu-west-1", `    - metric: ConsumedReadCapacityUnits
+      output_name: test_reload_split_by
+      statistics: [Average, Sum]
+      split_by:
+        name: Operation
+        values: [GetItem, Query]`))
+	assert.Eventually(t, func() bool {
+		return len(series(t, "test_reload_split_by")) == 2 && len(series(t, "test_reload_split_by_sum")) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	applyConfig(loadConfig(t, server.URL, "eu-west-1", `    - metric: ConsumedReadCapacityUnits
+      output_name: test_reload_split_by
+      statistics: [Average, Sum]`))
+	assert.Eventually(t, func() bool {
+		return len(series(t, "test_reload_split_by")) == 1 && len(series(t, "test_reload_split_by_sum")) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for _, name := range []string{"test_reload_split_by", "test_reload_split_by_sum"} {
+		for _, labels := range series(t, name) {
+			assert.NotContains(t, labels, "operation")
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package route53
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// ListTagsForResources accepts at most 10 resources per call
+const maxTagResourcesPerCall = 10
+
+func init() {
+	b.RegisterGlobalNamespace("AWS/Route53", Metrics, CreateResourceList)
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, hc *route53.HealthCheck) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("HealthCheckId"),
+			Value: hc.Id,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = hc.Id
+	rd.Name = hc.Id
+	if hc.HealthCheckConfig != nil && hc.HealthCheckConfig.FullyQualifiedDomainName != nil {
+		rd.Name = hc.HealthCheckConfig.FullyQualifiedDomainName
+	}
+	rd.Type = aws.String("route53-health-check")
+	rd.Parent = nd
+	rd.Tags = tags
+
+	return &rd, nil
+}
+
+// CreateResourceList fetches a list of all Route 53 health checks of the account
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating Route 53 resource list ...")
+	session := route53.New(nd.Parent.Session)
+	input := route53.ListHealthChecksInput{}
+	healthChecks := []*route53.HealthCheck{}
+	err := session.ListHealthChecksPages(&input, func(page *route53.ListHealthChecksOutput, lastPage bool) bool {
+		healthChecks = append(healthChecks, page.HealthChecks...)
+		return true
+	})
+	h.LogIfError(err)
+
+	resources := []*b.ResourceDescription{}
+	for i := 0; i < len(healthChecks); i += maxTagResourcesPerCall {
+		end := i + maxTagResourcesPerCall
+		if end > len(healthChecks) {
+			end = len(healthChecks)
+		}
+		batch := map[string]*route53.HealthCheck{}
+		ids := []*string{}
+		for _, hc := range healthChecks[i:end] {
+			batch[*hc.Id] = hc
+			ids = append(ids, hc.Id)
+		}
+
+		input := route53.ListTagsForResourcesInput{
+			ResourceType: aws.String(route53.TagResourceTypeHealthcheck),
+			ResourceIds:  ids,
+		}
+		tags, err := session.ListTagsForResources(&input)
+		if err != nil {
+			h.LogIfError(err)
+			continue
+		}
+
+		for _, ts := range tags.ResourceTagSets {
+			hc, ok := batch[aws.StringValue(ts.ResourceId)]
+			if !ok {
+				continue
+			}
+			tl, found := nd.Parent.TagsFound(ts)
+			if found {
+				if r, err := createResourceDescription(nd, tl, hc); err == nil {
+					resources = append(resources, r)
+				}
+				h.LogIfError(err)
+			}
+		}
+	}
+
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
@@ -0,0 +1,28 @@
+package route53
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"HealthCheckPercentageHealthy": {
+		Help:       aws.String("The percentage of Route 53 health checkers that consider the selected endpoint to be healthy"),
+		OutputName: aws.String("route53_health_check_percentage_healthy"),
+		Statistic:  h.StringPointers("Average", "Minimum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"HealthCheckStatus": {
+		Help:       aws.String("The status of the health check endpoint, 1 when healthy and 0 when unhealthy"),
+		OutputName: aws.String("route53_health_check_status"),
+		Statistic:  h.StringPointers("Minimum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
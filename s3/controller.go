@@ -10,7 +10,11 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-func createResourceDescription(nd *b.NamespaceDescription, tags *string, bucket *s3.Bucket) (*b.ResourceDescription, error) {
+func init() {
+	b.RegisterNamespace("AWS/S3", Metrics, CreateResourceList)
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, bucket *s3.Bucket) (*b.ResourceDescription, error) {
 	rd := b.ResourceDescription{}
 	dd := []*b.DimensionDescription{
 		{
@@ -74,10 +78,9 @@ func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 			}
 
 			tl, found := nd.Parent.TagsFound(tags)
-			ts := b.TagsToString(tl)
 
 			if found {
-				if r, err := createResourceDescription(nd, ts, bucket); err == nil {
+				if r, err := createResourceDescription(nd, tl, bucket); err == nil {
 					ch <- r
 				}
 				h.LogIfError(err)
@@ -0,0 +1,88 @@
+package sns
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	log "github.com/sirupsen/logrus"
+
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+)
+
+func init() {
+	b.RegisterNamespace("AWS/SNS", Metrics, CreateResourceList)
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, topic *sns.Topic) (*b.ResourceDescription, error) {
+	rd := b.ResourceDescription{}
+
+	topicName := h.GetLastStringElement(strings.Split(*topic.TopicArn, ":"))
+
+	dd := []*b.DimensionDescription{
+		{
+			Name:  aws.String("TopicName"),
+			Value: topicName,
+		},
+	}
+	if err := rd.BuildDimensions(dd); err != nil {
+		return nil, err
+	}
+
+	rd.ID = topic.TopicArn
+	rd.Name = topicName
+	rd.Type = aws.String("sns")
+	rd.Parent = nd
+	rd.Tags = tags
+
+	return &rd, nil
+}
+
+// CreateResourceList fetches a list of all SNS topics in the region
+func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("Creating SNS resource list ...")
+	session := sns.New(nd.Parent.Session)
+	input := sns.ListTopicsInput{}
+	topics := []*sns.Topic{}
+	err := session.ListTopicsPages(&input, func(page *sns.ListTopicsOutput, lastPage bool) bool {
+		topics = append(topics, page.Topics...)
+		return true
+	})
+	h.LogIfError(err)
+
+	var w sync.WaitGroup
+	w.Add(len(topics))
+	ch := make(chan *b.ResourceDescription, len(topics))
+	for _, topic := range topics {
+		go func(topic *sns.Topic, wg *sync.WaitGroup) {
+			defer wg.Done()
+			input := sns.ListTagsForResourceInput{
+				ResourceArn: topic.TopicArn,
+			}
+			tags, err := session.ListTagsForResource(&input)
+			h.LogIfError(err)
+
+			tl, found := nd.Parent.TagsFound(tags)
+
+			if found {
+				if r, err := createResourceDescription(nd, tl, topic); err == nil {
+					ch <- r
+				}
+				h.LogIfError(err)
+			}
+		}(topic, &w)
+	}
+	w.Wait()
+	close(ch)
+
+	resources := []*b.ResourceDescription{}
+	for r := range ch {
+		resources = append(resources, r)
+	}
+	nd.Mutex.Lock()
+	nd.Resources = resources
+	nd.Mutex.Unlock()
+}
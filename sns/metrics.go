@@ -0,0 +1,36 @@
+package sns
+
+import (
+	b "github.com/CoverGenius/cloudwatch-prometheus-exporter/base"
+	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// Metrics is a map of default MetricDescriptions for this namespace
+var Metrics = map[string]*b.MetricDescription{
+	"NumberOfMessagesPublished": {
+		Help:       aws.String("The number of messages published to the topic"),
+		OutputName: aws.String("sns_number_of_messages_published"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"NumberOfNotificationsDelivered": {
+		Help:       aws.String("The number of messages successfully delivered from the topic to subscribing endpoints"),
+		OutputName: aws.String("sns_number_of_notifications_delivered"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+	"NumberOfNotificationsFailed": {
+		Help:       aws.String("The number of messages that SNS failed to deliver"),
+		OutputName: aws.String("sns_number_of_notifications_failed"),
+		Statistic:  h.StringPointers("Sum"),
+		Kind:       aws.String(b.CLOUDWATCH_KIND),
+
+		Dimensions: []*cloudwatch.Dimension{},
+	},
+}
@@ -12,7 +12,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/sqs"
 )
 
-func createResourceDescription(nd *b.NamespaceDescription, tags *string, qu *string) (*b.ResourceDescription, error) {
+func init() {
+	b.RegisterNamespace("AWS/SQS", Metrics, CreateResourceList)
+}
+
+func createResourceDescription(nd *b.NamespaceDescription, tags []*b.TagDescription, qu *string) (*b.ResourceDescription, error) {
 	rd := b.ResourceDescription{}
 
 	parts := strings.Split(*qu, "/")
@@ -42,14 +46,21 @@ func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 	defer wg.Done()
 	log.Debug("Creating SQS resource list ...")
 	session := sqs.New(nd.Parent.Session)
-	input := sqs.ListQueuesInput{}
-	result, err := session.ListQueues(&input)
+	// ListQueues only returns a NextToken when MaxResults is set
+	input := sqs.ListQueuesInput{
+		MaxResults: aws.Int64(1000),
+	}
+	queueUrls := []*string{}
+	err := session.ListQueuesPages(&input, func(page *sqs.ListQueuesOutput, lastPage bool) bool {
+		queueUrls = append(queueUrls, page.QueueUrls...)
+		return true
+	})
 	h.LogIfError(err)
 
 	var w sync.WaitGroup
-	w.Add(len(result.QueueUrls))
-	ch := make(chan *b.ResourceDescription, len(result.QueueUrls))
-	for _, qu := range result.QueueUrls {
+	w.Add(len(queueUrls))
+	ch := make(chan *b.ResourceDescription, len(queueUrls))
+	for _, qu := range queueUrls {
 		go func(qu *string, wg *sync.WaitGroup) {
 			defer wg.Done()
 			input := sqs.ListQueueTagsInput{
@@ -59,10 +70,9 @@ func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 			h.LogIfError(err)
 
 			tl, found := nd.Parent.TagsFound(tags)
-			ts := b.TagsToString(tl)
 
 			if found {
-				if r, err := createResourceDescription(nd, ts, qu); err == nil {
+				if r, err := createResourceDescription(nd, tl, qu); err == nil {
 					ch <- r
 				}
 				h.LogIfError(err)
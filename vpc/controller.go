@@ -5,15 +5,16 @@ import (
 	h "github.com/CoverGenius/cloudwatch-prometheus-exporter/helpers"
 	log "github.com/sirupsen/logrus"
 
-	"fmt"
-	"sort"
-	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 )
 
+func init() {
+	b.RegisterNamespace("AWS/VPC", Metrics, CreateResourceList)
+}
+
 func createResourceDescription(nd *b.NamespaceDescription, subnet *ec2.Subnet) (*b.ResourceDescription, error) {
 	rd := b.ResourceDescription{}
 	dd := []*b.DimensionDescription{
@@ -26,22 +27,11 @@ func createResourceDescription(nd *b.NamespaceDescription, subnet *ec2.Subnet) (
 		return nil, err
 	}
 
-	tl := []string{}
-	tags := make(map[string]*string)
+	tl := []*b.TagDescription{}
 	for _, t := range subnet.Tags {
-		*t.Value = strings.ReplaceAll(*t.Value, " ", "_")
-		tags[*t.Key] = t.Value
-		ts := fmt.Sprintf("%s=%s", *t.Key, *t.Value)
-		tl = append(tl, ts)
-	}
-
-	sort.Strings(tl)
-
-	if len(tl) < 1 {
-		rd.Tags = aws.String("")
-	} else {
-		rd.Tags = aws.String(strings.Join(tl, ","))
+		tl = append(tl, &b.TagDescription{Key: t.Key, Value: t.Value})
 	}
+	rd.Tags = tl
 
 	rd.ID = subnet.SubnetId
 	rd.Name = subnet.SubnetId
@@ -60,16 +50,18 @@ func CreateResourceList(nd *b.NamespaceDescription, wg *sync.WaitGroup) {
 	input := ec2.DescribeSubnetsInput{
 		Filters: nd.Parent.Filters,
 	}
-	result, err := session.DescribeSubnets(&input)
-	h.LogIfError(err)
-
 	resources := []*b.ResourceDescription{}
-	for _, subnet := range result.Subnets {
-		if r, err := createResourceDescription(nd, subnet); err == nil {
-			resources = append(resources, r)
+	err := session.DescribeSubnetsPages(&input, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		for _, subnet := range page.Subnets {
+			r, err := createResourceDescription(nd, subnet)
+			if err == nil {
+				resources = append(resources, r)
+			}
+			h.LogIfError(err)
 		}
-		h.LogIfError(err)
-	}
+		return true
+	})
+	h.LogIfError(err)
 	nd.Mutex.Lock()
 	nd.Resources = resources
 	nd.Mutex.Unlock()
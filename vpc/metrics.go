@@ -54,15 +54,9 @@ func gatherAvailableIPFunc(rds []*b.ResourceDescription, start time.Time, end ti
 		}
 		metric := b.NonCloudWatchMetric{
 			Timestamps: []*time.Time{aws.Time(time.Now())},
-			Label: aws.String((&b.AwsLabels{
-				Statistic: "Average",
-				Name:      *rd.Name,
-				Id:        *rd.ID,
-				RType:     *rd.Type,
-				Region:    *rd.Parent.Parent.Region,
-				Tags:      *rd.Tags,
-			}).String()),
-			Values: []*float64{aws.Float64(float64(*subnet.AvailableIpAddressCount))},
+			Statistic:  "Average",
+			Resource:   rd,
+			Values:     []*float64{aws.Float64(float64(*subnet.AvailableIpAddressCount))},
 		}
 		result[idx] = &metric
 	}
@@ -108,15 +102,9 @@ func gatherTotalIPFunc(rds []*b.ResourceDescription, start time.Time, end time.T
 
 		metric := b.NonCloudWatchMetric{
 			Timestamps: []*time.Time{aws.Time(time.Now())},
-			Label: aws.String((&b.AwsLabels{
-				Statistic: "Average",
-				Name:      *rd.Name,
-				Id:        *rd.ID,
-				RType:     *rd.Type,
-				Region:    *rd.Parent.Parent.Region,
-				Tags:      *rd.Tags,
-			}).String()),
-			Values: []*float64{aws.Float64(float64(h.IntPow(2, 32-netmask)))},
+			Statistic:  "Average",
+			Resource:   rd,
+			Values:     []*float64{aws.Float64(float64(h.IntPow(2, 32-netmask)))},
 		}
 		result[idx] = &metric
 	}